@@ -0,0 +1,118 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newBenchmarkLocale
+func newBenchmarkLocale() *Locale {
+	i := New("en-us")
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"test_message":  "This is a test message.",
+			"test_template": "Hello, {{ .Name }}!",
+			"test_plural":   "No apples | 1 apple | Many apples",
+			"test_count":    "No apples | 1 apple | {{ .Count }} apples",
+			"Post <verb>":   "Post something",
+			"Post <noun>":   "A post",
+		},
+	})
+	return i.NewLocale("en-us")
+}
+
+func TestZeroAllocations(t *testing.T) {
+	assert := assert.New(t)
+
+	l := newBenchmarkLocale()
+	buf := make([]byte, 0, 64)
+	l.String("test_missing")
+
+	for name, fn := range map[string]func(){
+		"String":       func() { l.String("test_message") },
+		"StringX":      func() { l.StringX("Post", "verb") },
+		"Number":       func() { l.Number("test_plural", 2) },
+		"NumberX":      func() { l.NumberX("Post", "noun", 2) },
+		"Missing":      func() { l.String("test_missing") },
+		"AppendString": func() { buf = l.AppendString(buf[:0], "test_message") },
+		"AppendNumber": func() { buf = l.AppendNumber(buf[:0], "test_plural", 2) },
+	} {
+		assert.Zero(testing.AllocsPerRun(100, fn), name)
+	}
+	assert.Equal("Many apples", string(l.AppendNumber(nil, "test_plural", 2)))
+	assert.Equal("Hello, Yami!", string(l.AppendString([]byte("Hello, Yami!")[:0], "test_template", map[string]any{"Name": "Yami"})))
+	assert.Equal("> 3 apples", string(l.AppendNumber([]byte("> "), "test_count", 3)))
+}
+
+func BenchmarkString(b *testing.B) {
+	l := newBenchmarkLocale()
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		l.String("test_message")
+	}
+}
+
+func BenchmarkStringTemplate(b *testing.B) {
+	l := newBenchmarkLocale()
+	data := map[string]any{
+		"Name": "Yami",
+	}
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		l.String("test_template", data)
+	}
+}
+
+func BenchmarkStringX(b *testing.B) {
+	l := newBenchmarkLocale()
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		l.StringX("Post", "verb")
+	}
+}
+
+func BenchmarkNumber(b *testing.B) {
+	l := newBenchmarkLocale()
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		l.Number("test_plural", 2)
+	}
+}
+
+func BenchmarkNumberTemplate(b *testing.B) {
+	l := newBenchmarkLocale()
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		l.Number("test_count", 2)
+	}
+}
+
+func BenchmarkMissing(b *testing.B) {
+	l := newBenchmarkLocale()
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		l.String("test_missing")
+	}
+}
+
+func BenchmarkAppendString(b *testing.B) {
+	l := newBenchmarkLocale()
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		buf = l.AppendString(buf[:0], "test_message")
+	}
+}
+
+func BenchmarkAppendStringTemplate(b *testing.B) {
+	l := newBenchmarkLocale()
+	buf := make([]byte, 0, 64)
+	data := map[string]any{
+		"Name": "Yami",
+	}
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		buf = l.AppendString(buf[:0], "test_template", data)
+	}
+}
@@ -0,0 +1,308 @@
+package i18n
+
+import "strings"
+
+// numberSymbols is the symbols of a numbering system in a locale.
+type numberSymbols struct {
+	digits   string
+	decimal  string
+	group    string
+	minus    string
+	exponent string
+	percent  string
+}
+
+// localeData is the formatting conventions of a locale, derived from the CLDR.
+type localeData struct {
+	latin             numberSymbols
+	native            *numberSymbols
+	primaryGrouping   int
+	secondaryGrouping int
+	minimumGrouping   int
+	currency          string
+	accounting        string
+	currencySymbols   map[string]string
+}
+
+// derive copies the locale data and modifies it by the function.
+func (d *localeData) derive(fn func(*localeData)) *localeData {
+	v := *d
+	fn(&v)
+	return &v
+}
+
+// symbols returns the symbols of the native numbering system if it's available and requested.
+func (d *localeData) symbols(native bool) *numberSymbols {
+	if native && d.native != nil {
+		return d.native
+	}
+	return &d.latin
+}
+
+const (
+	// nbsp is a no-break space.
+	nbsp = "\u00a0"
+	// nnbsp is a narrow no-break space.
+	nnbsp = "\u202f"
+)
+
+// latinSymbols is the common `1,234.5` symbols.
+var latinSymbols = numberSymbols{
+	digits:   "0123456789",
+	decimal:  ".",
+	group:    ",",
+	minus:    "-",
+	exponent: "E",
+	percent:  "{0}%",
+}
+
+// withSymbols modifies a copy of the latin symbols.
+func withSymbols(fn func(*numberSymbols)) numberSymbols {
+	v := latinSymbols
+	fn(&v)
+	return v
+}
+
+// localeDatas is the supported locale data, keyed by the languages or the `language-region`.
+var localeDatas = map[string]*localeData{
+	"en": {
+		latin:             latinSymbols,
+		primaryGrouping:   3,
+		secondaryGrouping: 3,
+		minimumGrouping:   1,
+		currency:          "¤{0}",
+		accounting:        "(¤{0})",
+		currencySymbols:   map[string]string{"USD": "$", "JPY": "¥"},
+	},
+	"zh": {
+		latin:             latinSymbols,
+		native:            &numberSymbols{digits: "〇一二三四五六七八九", decimal: ".", group: ",", minus: "-", exponent: "E", percent: "{0}%"},
+		primaryGrouping:   3,
+		secondaryGrouping: 3,
+		minimumGrouping:   1,
+		currency:          "¤{0}",
+		accounting:        "(¤{0})",
+		currencySymbols:   map[string]string{"CNY": "¥", "USD": "US$"},
+	},
+	"ja": {
+		latin:             latinSymbols,
+		native:            &numberSymbols{digits: "〇一二三四五六七八九", decimal: ".", group: ",", minus: "-", exponent: "E", percent: "{0}%"},
+		primaryGrouping:   3,
+		secondaryGrouping: 3,
+		minimumGrouping:   1,
+		currency:          "¤{0}",
+		accounting:        "(¤{0})",
+		currencySymbols:   map[string]string{"JPY": "￥", "USD": "$", "CNY": "元"},
+	},
+	"ko": {
+		latin:             latinSymbols,
+		primaryGrouping:   3,
+		secondaryGrouping: 3,
+		minimumGrouping:   1,
+		currency:          "¤{0}",
+		accounting:        "(¤{0})",
+		currencySymbols:   map[string]string{"USD": "US$"},
+	},
+	"de": {
+		latin: withSymbols(func(s *numberSymbols) {
+			s.decimal, s.group, s.percent = ",", ".", "{0}"+nbsp+"%"
+		}),
+		primaryGrouping:   3,
+		secondaryGrouping: 3,
+		minimumGrouping:   1,
+		currency:          "{0}" + nbsp + "¤",
+		currencySymbols:   map[string]string{"USD": "$"},
+	},
+	"fr": {
+		latin: withSymbols(func(s *numberSymbols) {
+			s.decimal, s.group, s.percent = ",", nnbsp, "{0}"+nnbsp+"%"
+		}),
+		primaryGrouping:   3,
+		secondaryGrouping: 3,
+		minimumGrouping:   1,
+		currency:          "{0}" + nbsp + "¤",
+		accounting:        "({0}" + nbsp + "¤)",
+		currencySymbols:   map[string]string{"USD": "$US"},
+	},
+	"es": {
+		latin: withSymbols(func(s *numberSymbols) {
+			s.decimal, s.group, s.percent = ",", ".", "{0}"+nbsp+"%"
+		}),
+		primaryGrouping:   3,
+		secondaryGrouping: 3,
+		minimumGrouping:   2,
+		currency:          "{0}" + nbsp + "¤",
+		currencySymbols:   map[string]string{"USD": "US$"},
+	},
+	"it": {
+		latin: withSymbols(func(s *numberSymbols) {
+			s.decimal, s.group = ",", "."
+		}),
+		primaryGrouping:   3,
+		secondaryGrouping: 3,
+		minimumGrouping:   1,
+		currency:          "{0}" + nbsp + "¤",
+		currencySymbols:   map[string]string{"USD": "USD"},
+	},
+	"pt": {
+		latin: withSymbols(func(s *numberSymbols) {
+			s.decimal, s.group = ",", "."
+		}),
+		primaryGrouping:   3,
+		secondaryGrouping: 3,
+		minimumGrouping:   1,
+		currency:          "¤" + nbsp + "{0}",
+		currencySymbols:   map[string]string{"USD": "US$"},
+	},
+	"nl": {
+		latin: withSymbols(func(s *numberSymbols) {
+			s.decimal, s.group = ",", "."
+		}),
+		primaryGrouping:   3,
+		secondaryGrouping: 3,
+		minimumGrouping:   1,
+		currency:          "¤" + nbsp + "{0}",
+		accounting:        "(¤" + nbsp + "{0})",
+		currencySymbols:   map[string]string{"USD": "US$"},
+	},
+	"ru": {
+		latin: withSymbols(func(s *numberSymbols) {
+			s.decimal, s.group, s.percent = ",", nbsp, "{0}"+nbsp+"%"
+		}),
+		primaryGrouping:   3,
+		secondaryGrouping: 3,
+		minimumGrouping:   1,
+		currency:          "{0}" + nbsp + "¤",
+		currencySymbols:   map[string]string{"RUB": "₽", "USD": "$"},
+	},
+	"sv": {
+		latin: withSymbols(func(s *numberSymbols) {
+			s.decimal, s.group, s.minus, s.exponent, s.percent = ",", nbsp, "−", "×10^", "{0}"+nbsp+"%"
+		}),
+		primaryGrouping:   3,
+		secondaryGrouping: 3,
+		minimumGrouping:   1,
+		currency:          "{0}" + nbsp + "¤",
+		currencySymbols:   map[string]string{"SEK": "kr", "USD": "US$"},
+	},
+	"ar": {
+		latin:             latinSymbols,
+		native:            &numberSymbols{digits: "٠١٢٣٤٥٦٧٨٩", decimal: "٫", group: "٬", minus: "\u061c-", exponent: "أس", percent: "{0}٪\u061c"},
+		primaryGrouping:   3,
+		secondaryGrouping: 3,
+		minimumGrouping:   1,
+		currency:          "{0}" + nbsp + "¤",
+		currencySymbols:   map[string]string{"USD": "US$"},
+	},
+	"fa": {
+		latin:             latinSymbols,
+		native:            &numberSymbols{digits: "۰۱۲۳۴۵۶۷۸۹", decimal: "٫", group: "٬", minus: "\u200e−", exponent: "×۱۰^", percent: "{0}٪"},
+		primaryGrouping:   3,
+		secondaryGrouping: 3,
+		minimumGrouping:   1,
+		currency:          "\u200e¤{0}",
+		accounting:        "\u200e(¤{0})",
+		currencySymbols:   map[string]string{"IRR": "ریال", "USD": "$"},
+	},
+	"hi": {
+		latin:             latinSymbols,
+		native:            &numberSymbols{digits: "०१२३४५६७८९", decimal: ".", group: ",", minus: "-", exponent: "E", percent: "{0}%"},
+		primaryGrouping:   3,
+		secondaryGrouping: 2,
+		minimumGrouping:   1,
+		currency:          "¤{0}",
+		currencySymbols:   map[string]string{"USD": "$"},
+	},
+	"th": {
+		latin:             latinSymbols,
+		native:            &numberSymbols{digits: "๐๑๒๓๔๕๖๗๘๙", decimal: ".", group: ",", minus: "-", exponent: "E", percent: "{0}%"},
+		primaryGrouping:   3,
+		secondaryGrouping: 3,
+		minimumGrouping:   1,
+		currency:          "¤{0}",
+		accounting:        "(¤{0})",
+		currencySymbols:   map[string]string{"THB": "฿", "USD": "US$"},
+	},
+}
+
+func init() {
+	localeDatas["en-in"] = localeDatas["en"].derive(func(d *localeData) {
+		d.secondaryGrouping = 2
+		d.currencySymbols = map[string]string{"USD": "$", "INR": "₹"}
+	})
+	localeDatas["en-gb"] = localeDatas["en"].derive(func(d *localeData) {
+		d.currencySymbols = map[string]string{"USD": "US$", "JPY": "JP¥"}
+	})
+	localeDatas["zh-tw"] = localeDatas["zh"].derive(func(d *localeData) {
+		d.currencySymbols = map[string]string{"TWD": "$", "USD": "US$", "JPY": "¥"}
+	})
+	localeDatas["zh-hk"] = localeDatas["zh"].derive(func(d *localeData) {
+		d.currencySymbols = map[string]string{"HKD": "HK$", "USD": "US$", "JPY": "¥"}
+	})
+	localeDatas["de-ch"] = localeDatas["de"].derive(func(d *localeData) {
+		d.latin.decimal, d.latin.group, d.latin.percent = ".", "’", "{0}%"
+		d.currency, d.accounting = "¤"+nbsp+"{0}", "¤-{0}"
+	})
+	localeDatas["pt-pt"] = localeDatas["pt"].derive(func(d *localeData) {
+		d.latin.group, d.minimumGrouping = nbsp, 2
+	})
+}
+
+// currencies is the ISO 4217 digits, the cash digits, the international symbols and the narrow symbols of the currencies.
+var currencies = map[string]struct {
+	digits       int
+	cashDigits   int
+	symbol       string
+	narrowSymbol string
+}{
+	"USD": {2, 2, "US$", "$"},
+	"EUR": {2, 2, "€", "€"},
+	"GBP": {2, 2, "£", "£"},
+	"JPY": {0, 0, "JP¥", "¥"},
+	"CNY": {2, 2, "CN¥", "¥"},
+	"TWD": {2, 0, "NT$", "$"},
+	"HKD": {2, 2, "HK$", "$"},
+	"KRW": {0, 0, "₩", "₩"},
+	"INR": {2, 2, "₹", "₹"},
+	"CAD": {2, 2, "CA$", "$"},
+	"AUD": {2, 2, "A$", "$"},
+	"NZD": {2, 2, "NZ$", "$"},
+	"SGD": {2, 2, "SGD", "$"},
+	"CHF": {2, 2, "CHF", "CHF"},
+	"SEK": {2, 0, "SEK", "kr"},
+	"NOK": {2, 0, "NOK", "kr"},
+	"DKK": {2, 2, "DKK", "kr"},
+	"RUB": {2, 2, "RUB", "₽"},
+	"BRL": {2, 2, "R$", "R$"},
+	"MXN": {2, 2, "MX$", "$"},
+	"THB": {2, 2, "THB", "฿"},
+	"VND": {0, 0, "₫", "₫"},
+	"IDR": {2, 0, "IDR", "Rp"},
+	"HUF": {2, 0, "HUF", "Ft"},
+	"ISK": {0, 0, "ISK", "kr"},
+	"CLP": {0, 0, "CLP", "$"},
+	"IRR": {0, 0, "IRR", "IRR"},
+	"BHD": {3, 3, "BHD", "BHD"},
+	"KWD": {3, 3, "KWD", "KWD"},
+	"JOD": {3, 3, "JOD", "JOD"},
+	"OMR": {3, 3, "OMR", "OMR"},
+	"TND": {3, 3, "TND", "TND"},
+}
+
+// lookupLocaleData returns the number data of the locale.
+func lookupLocaleData(locale string) *localeData {
+	return lookupLocale(localeDatas, locale)
+}
+
+// lookupLocale returns the data of the locale, or the language of the locale, or English if none was found.
+func lookupLocale[T any](datas map[string]T, locale string) T {
+	if d, ok := datas[locale]; ok {
+		return d
+	}
+	if language, _, ok := strings.Cut(locale, "-"); ok {
+		if d, ok := datas[language]; ok {
+			return d
+		}
+	}
+	return datas["en"]
+}
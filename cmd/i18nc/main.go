@@ -0,0 +1,94 @@
+// Command i18nc compiles the translation files to a Go source file with a `i18n.CompiledCatalog`,
+// so the translations can be loaded by `I18n.LoadCompiled` without unmarshaling and parsing at startup.
+//
+// Usage with `go generate`:
+//
+//	//go:generate go run github.com/teacat/i18n/cmd/i18nc -default zh-tw -fallback ja-jp=ko-kr -pkg lang -out lang/catalog.go languages/*.json
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"strings"
+
+	"github.com/teacat/i18n"
+)
+
+// fallbackFlag collects the `-fallback zh-tw=zh-hk,zh-cn` flags.
+type fallbackFlag map[string][]string
+
+// String
+func (f fallbackFlag) String() string {
+	return fmt.Sprint(map[string][]string(f))
+}
+
+// Set
+func (f fallbackFlag) Set(v string) error {
+	locale, fallbacks, ok := strings.Cut(v, "=")
+	if !ok || locale == "" || fallbacks == "" {
+		return fmt.Errorf("fallback %q should be like `zh-tw=zh-hk,zh-cn`", v)
+	}
+	f[locale] = append(f[locale], strings.Split(fallbacks, ",")...)
+	return nil
+}
+
+func main() {
+	fallbacks := make(fallbackFlag)
+	pkg := flag.String("pkg", "lang", "the package name of the generated file")
+	out := flag.String("out", "catalog.go", "the path of the generated file")
+	name := flag.String("var", "Catalog", "the variable name of the compiled catalog")
+	defaultLocale := flag.String("default", "", "the default locale")
+	flag.Var(fallbacks, "fallback", "the fallbacks of a locale like `zh-tw=zh-hk,zh-cn`, can be repeated")
+	flag.Parse()
+
+	if *defaultLocale == "" || flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "i18nc: the default locale and the translation files are required")
+		os.Exit(2)
+	}
+	i := i18n.New(*defaultLocale, i18n.WithFallback(fallbacks))
+	if err := i.LoadGlob(flag.Args()...); err != nil {
+		fmt.Fprintln(os.Stderr, "i18nc:", err)
+		os.Exit(1)
+	}
+	src, err := generate(*pkg, *name, i.Compile())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "i18nc:", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, "i18nc:", err)
+		os.Exit(1)
+	}
+}
+
+// generate generates the Go source of the compiled catalog.
+func generate(pkg, name string, c *i18n.CompiledCatalog) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by i18nc. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "import \"github.com/teacat/i18n\"\n\n")
+	fmt.Fprintf(&b, "// %s is the compiled translations, load it with `I18n.LoadCompiled`.\n", name)
+	fmt.Fprintf(&b, "var %s = &i18n.CompiledCatalog{\n", name)
+	fmt.Fprintf(&b, "DefaultLocale: %q,\n", c.DefaultLocale)
+	fmt.Fprintf(&b, "Locales: []i18n.CompiledLocale{\n")
+	for _, l := range c.Locales {
+		fmt.Fprintf(&b, "{\nLocale: %q,\nMessages: []i18n.CompiledMessage{\n", l.Locale)
+		for _, m := range l.Messages {
+			fmt.Fprintf(&b, "{Name: %q, ", m.Name)
+			if m.Origin != "" {
+				fmt.Fprintf(&b, "Origin: %q, ", m.Origin)
+			}
+			texts := make([]string, len(m.Texts))
+			for j, v := range m.Texts {
+				texts[j] = fmt.Sprintf("%q", v)
+			}
+			fmt.Fprintf(&b, "Texts: []string{%s}},\n", strings.Join(texts, ", "))
+		}
+		fmt.Fprintf(&b, "},\n},\n")
+	}
+	fmt.Fprintf(&b, "},\n}\n")
+	return format.Source(b.Bytes())
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/teacat/i18n"
+)
+
+func TestGenerate(t *testing.T) {
+	assert := assert.New(t)
+
+	i := i18n.New("zh-tw", i18n.WithFallback(map[string][]string{
+		"ja-jp": []string{"zh-tw"},
+	}))
+	i.LoadMap(map[string]map[string]string{
+		"zh-tw": map[string]string{
+			"test_message": "這是一則測試訊息。",
+			"test_plural":  "沒有 | 只有 1 個 | 有 {{.Count}} 個",
+		},
+		"ja-jp": map[string]string{
+			"test_message": "これはテストメッセージです。",
+		},
+	})
+	src, err := generate("lang", "Catalog", i.Compile())
+	assert.NoError(err)
+
+	code := string(src)
+	assert.Contains(code, "package lang")
+	assert.Contains(code, "var Catalog = &i18n.CompiledCatalog{")
+	assert.Contains(code, `DefaultLocale: "zh-tw",`)
+	assert.Contains(code, `{Name: "test_message", Texts: []string{"これはテストメッセージです。"}},`)
+	assert.Contains(code, `{Name: "test_plural", Origin: "zh-tw", Texts: []string{"沒有", "只有 1 個", "有 {{.Count}} 個"}},`)
+}
+
+func TestFallbackFlag(t *testing.T) {
+	assert := assert.New(t)
+
+	f := make(fallbackFlag)
+	assert.NoError(f.Set("zh-tw=zh-hk,zh-cn"))
+	assert.NoError(f.Set("en-gb=en-us"))
+	assert.Error(f.Set("zh-tw"))
+	assert.Equal([]string{"zh-hk", "zh-cn"}, f["zh-tw"])
+	assert.Equal([]string{"en-us"}, f["en-gb"])
+}
@@ -0,0 +1,401 @@
+// Command i18ngen generates type-safe translation functions from the translation files of the default locale,
+// so renaming or removing a translation breaks the build instead of printing the raw key.
+//
+// Usage with `go generate`:
+//
+//	//go:generate go run github.com/teacat/i18n/cmd/i18ngen -pkg msg -out msg/msg.go languages/en-us.json
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template/parse"
+	"unicode"
+
+	"github.com/teacat/i18n"
+	"gopkg.in/yaml.v3"
+)
+
+// contextRegExp and variantRegExp match the contexts and the variants like the `i18n` package does,
+// only the last brackets are used so `a <b> <c>` is `a <b>` with the context `c`.
+var (
+	contextRegExp = regexp.MustCompile(" <([^<>]*)>$")
+	variantRegExp = regexp.MustCompile(` \[([^\[\]]*)\]$`)
+	actionRegExp  = regexp.MustCompile("{{.*?}}")
+	braceRegExp   = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+)
+
+// unmarshalers are the unmarshalers of the file extensions like the `i18n` package.
+var unmarshalers = map[string]i18n.Unmarshaler{
+	".json":       json.Unmarshal,
+	".yaml":       yaml.Unmarshal,
+	".yml":        yaml.Unmarshal,
+	".toml":       i18n.UnmarshalTOML,
+	".po":         i18n.UnmarshalPO,
+	".properties": i18n.UnmarshalProperties,
+}
+
+// message is a translation to be generated as a function.
+type message struct {
+	key     string
+	text    string
+	name    string
+	context string
+	plural  bool
+	selects bool
+	fields  []string
+}
+
+func main() {
+	pkg := flag.String("pkg", "msg", "the package name of the generated file")
+	out := flag.String("out", "msg.go", "the path of the generated file")
+	typ := flag.String("type", "Msg", "the variable name that holds the generated functions")
+	braces := flag.Bool("braces", false, "the translations use the `{Name}` placeholders (i18n.PlaceholderBrace)")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "i18ngen: no translation files of the default locale were specified")
+		os.Exit(2)
+	}
+	translations := make(map[string]string)
+	for _, v := range flag.Args() {
+		trans, err := readFile(v)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "i18ngen:", err)
+			os.Exit(1)
+		}
+		for name, text := range trans {
+			translations[name] = text
+		}
+	}
+	src, err := generate(*pkg, *typ, *braces, translations)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "i18ngen:", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, "i18ngen:", err)
+		os.Exit(1)
+	}
+}
+
+// readFile reads the translation file with the unmarshaler of its extension.
+func readFile(filename string) (map[string]string, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	ext := strings.ToLower(filepath.Ext(filename))
+	u, ok := unmarshalers[ext]
+	if !ok {
+		return nil, fmt.Errorf("%s: no unmarshaler for the %q extension", filename, ext)
+	}
+	var trans map[string]string
+	if err := u(b, &trans); err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	return trans, nil
+}
+
+// generate generates the Go source of the translations, the `{Name}` placeholders are parameters too if `braces` is true.
+func generate(pkg, typ string, braces bool, translations map[string]string) ([]byte, error) {
+	keys := make([]string, 0, len(translations))
+	for k := range translations {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var messages []*message
+	used := make(map[string]bool)
+	variants := make(map[string]*message)
+
+	for _, k := range keys {
+		text := translations[k]
+		// The variants (e.g. `name [female]`) are generated as a single function that selects the variant.
+		base, selects := k, false
+		if loc := variantRegExp.FindStringIndex(k); loc != nil {
+			base, selects = k[:loc[0]], true
+		}
+		fields, err := templateFields(text, braces)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", k, err)
+		}
+		if m, ok := variants[base]; ok {
+			m.selects = m.selects || selects
+			m.plural = m.plural || len(splitForms(text)) > 1
+			m.fields = mergeFields(m.fields, fields)
+			continue
+		}
+		m := &message{
+			key:     base,
+			text:    text,
+			selects: selects,
+			fields:  fields,
+		}
+		if loc := contextRegExp.FindStringSubmatchIndex(base); loc != nil {
+			m.key, m.context = base[:loc[0]], base[loc[2]:loc[3]]
+		}
+		m.plural = len(splitForms(m.text)) > 1 || len(splitForms(m.key)) > 1
+		m.name = uniqueName(identifier(m.key+" "+m.context, len(messages)), used)
+		variants[base] = m
+		messages = append(messages, m)
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by i18ngen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "import \"github.com/teacat/i18n\"\n\n")
+	fmt.Fprintf(&b, "// %s provides the type-safe translations.\n", typ)
+	fmt.Fprintf(&b, "var %s %s\n\n", typ, unexported(typ))
+	fmt.Fprintf(&b, "type %s struct{}\n", unexported(typ))
+
+	for _, m := range messages {
+		writeMessage(&b, unexported(typ), m)
+	}
+	return format.Source(b.Bytes())
+}
+
+// writeMessage
+func writeMessage(b *bytes.Buffer, typ string, m *message) {
+	params := []string{"l *i18n.Locale"}
+	if m.selects {
+		params = append(params, "value string")
+	}
+	if m.plural {
+		params = append(params, "count int")
+	}
+	var data []string
+	for _, f := range m.fields {
+		if m.plural && f == "Count" {
+			data = append(data, fmt.Sprintf("%q: count,", f))
+			continue
+		}
+		p := parameter(f)
+		params = append(params, p+" any")
+		data = append(data, fmt.Sprintf("%q: %s,", f, p))
+	}
+
+	var call string
+	switch {
+	case m.selects && m.plural && m.context != "":
+		call = fmt.Sprintf("l.SelectNumberX(%q, %q, value, count", m.key, m.context)
+	case m.selects && m.plural:
+		call = fmt.Sprintf("l.SelectNumber(%q, value, count", m.key)
+	case m.selects && m.context != "":
+		call = fmt.Sprintf("l.SelectX(%q, %q, value", m.key, m.context)
+	case m.selects:
+		call = fmt.Sprintf("l.Select(%q, value", m.key)
+	case m.plural && m.context != "":
+		call = fmt.Sprintf("l.NumberX(%q, %q, count", m.key, m.context)
+	case m.plural:
+		call = fmt.Sprintf("l.Number(%q, count", m.key)
+	case m.context != "":
+		call = fmt.Sprintf("l.StringX(%q, %q", m.key, m.context)
+	default:
+		call = fmt.Sprintf("l.String(%q", m.key)
+	}
+
+	fmt.Fprintf(b, "\n// %s translates %q.\n", m.name, m.text)
+	fmt.Fprintf(b, "func (%s) %s(%s) string {\n", typ, m.name, strings.Join(params, ", "))
+	if len(data) == 0 {
+		fmt.Fprintf(b, "return %s)\n", call)
+	} else {
+		fmt.Fprintf(b, "return %s, map[string]any{\n%s\n})\n", call, strings.Join(data, "\n"))
+	}
+	fmt.Fprintf(b, "}\n")
+}
+
+// templateFields returns the top-level fields (e.g. `.Name`) that were used by the translation,
+// and the names of the `{Name}` placeholders in the texts that aren't templates if `braces` is true.
+func templateFields(text string, braces bool) ([]string, error) {
+	var fields []string
+	seen := make(map[string]bool)
+
+	for _, v := range splitForms(text) {
+		if !strings.Contains(v, "{{") {
+			if !braces {
+				continue
+			}
+			for _, m := range braceRegExp.FindAllStringSubmatch(v, -1) {
+				if !seen[m[1]] {
+					seen[m[1]] = true
+					fields = append(fields, m[1])
+				}
+			}
+			continue
+		}
+		t := parse.New("")
+		t.Mode = parse.SkipFuncCheck
+		trees := make(map[string]*parse.Tree)
+		if _, err := t.Parse(v, "", "", trees); err != nil {
+			return nil, err
+		}
+		for _, tree := range trees {
+			walkFields(tree.Root, true, func(f string) {
+				if !seen[f] {
+					seen[f] = true
+					fields = append(fields, f)
+				}
+			})
+		}
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
+
+// splitForms splits the translation into the plural forms like the `i18n` package does,
+// the pipes in the template actions and the escaped pipes (`\|`) are not separators.
+func splitForms(text string) []string {
+	var (
+		forms  []string
+		start  int
+		action bool
+	)
+	for j := 0; j < len(text); j++ {
+		switch {
+		case action:
+			if strings.HasPrefix(text[j:], "}}") {
+				action = false
+				j++
+			}
+		case strings.HasPrefix(text[j:], "{{"):
+			action = true
+			j++
+		case text[j] == '\\':
+			j++
+		case strings.HasPrefix(text[j:], " | "):
+			forms = append(forms, text[start:j])
+			start = j + 3
+			j += 2
+		}
+	}
+	return append(forms, text[start:])
+}
+
+// mergeFields merges the sorted fields of the variants.
+func mergeFields(a, b []string) []string {
+	seen := make(map[string]bool)
+	var fields []string
+	for _, v := range append(a, b...) {
+		if !seen[v] {
+			seen[v] = true
+			fields = append(fields, v)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// walkFields walks through the template nodes, the fields in the bodies of `range` and `with` are skipped
+// since the dot was changed in there, only the `$.Field` variables will be collected.
+func walkFields(node parse.Node, dot bool, fn func(string)) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, v := range n.Nodes {
+			walkFields(v, dot, fn)
+		}
+	case *parse.ActionNode:
+		walkFields(n.Pipe, dot, fn)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, c := range n.Cmds {
+			walkFields(c, dot, fn)
+		}
+	case *parse.CommandNode:
+		for _, v := range n.Args {
+			walkFields(v, dot, fn)
+		}
+	case *parse.ChainNode:
+		walkFields(n.Node, dot, fn)
+	case *parse.FieldNode:
+		if dot {
+			fn(n.Ident[0])
+		}
+	case *parse.VariableNode:
+		if len(n.Ident) > 1 && n.Ident[0] == "$" {
+			fn(n.Ident[1])
+		}
+	case *parse.IfNode:
+		walkFields(n.Pipe, dot, fn)
+		walkFields(n.List, dot, fn)
+		walkFields(n.ElseList, dot, fn)
+	case *parse.RangeNode:
+		walkFields(n.Pipe, dot, fn)
+		walkFields(n.List, false, fn)
+		walkFields(n.ElseList, dot, fn)
+	case *parse.WithNode:
+		walkFields(n.Pipe, dot, fn)
+		walkFields(n.List, false, fn)
+		walkFields(n.ElseList, dot, fn)
+	}
+}
+
+// identifier converts `test_plural` or `Hello, {{ .Name }}!` to `TestPlural` and `Hello`.
+func identifier(key string, index int) string {
+	key = actionRegExp.ReplaceAllString(key, " ")
+
+	var b strings.Builder
+	upper := true
+	for _, r := range key {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	v := b.String()
+	if v == "" {
+		return fmt.Sprintf("Key%d", index)
+	}
+	if unicode.IsDigit(rune(v[0])) {
+		return "N" + v
+	}
+	return v
+}
+
+// uniqueName appends a number to the name if it was used.
+func uniqueName(name string, used map[string]bool) string {
+	v := name
+	for j := 2; used[v]; j++ {
+		v = fmt.Sprintf("%s%d", name, j)
+	}
+	used[v] = true
+	return v
+}
+
+// parameter converts the field `Name` to a parameter name `name`.
+func parameter(field string) string {
+	v := unexported(field)
+	if token.IsKeyword(v) || v == "l" || v == "count" || v == "value" || v == "i18n" {
+		return v + "_"
+	}
+	return v
+}
+
+// unexported lowercases the first letter.
+func unexported(v string) string {
+	if v == "" {
+		return v
+	}
+	r := []rune(v)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate(t *testing.T) {
+	assert := assert.New(t)
+
+	src, err := generate("msg", "Msg", false, map[string]string{
+		"test_message":  "這是一則測試訊息。",
+		"test_template": "你好，{{ .Name }}！",
+		"test_plural":   "沒有 | 只有 1 個 | 有 {{ .Count }} 個",
+		"test_range":    "{{ range .Users }}{{ .Name }}{{ $.Separator }}{{ end }}",
+		"Post <verb>":   "發表貼文",
+		"test_pipe":     "{{ .Name | upper }} \\| 輸入",
+		"No Post | 1 Post | {{ .Count }} Posts <noun>": "沒有文章 | 1 篇文章 | 有 {{ .Count }} 篇文章",
+	})
+	assert.NoError(err)
+
+	code := string(src)
+	assert.Contains(code, "package msg")
+	assert.Contains(code, "var Msg msg")
+	assert.Contains(code, `func (msg) TestMessage(l *i18n.Locale) string {
+	return l.String("test_message")
+}`)
+	assert.Contains(code, `func (msg) TestTemplate(l *i18n.Locale, name any) string {
+	return l.String("test_template", map[string]any{
+		"Name": name,
+	})
+}`)
+	assert.Contains(code, `func (msg) TestPlural(l *i18n.Locale, count int) string {
+	return l.Number("test_plural", count, map[string]any{
+		"Count": count,
+	})
+}`)
+	assert.Contains(code, `func (msg) TestRange(l *i18n.Locale, separator any, users any) string {`)
+	assert.Contains(code, `func (msg) TestPipe(l *i18n.Locale, name any) string {
+	return l.String("test_pipe", map[string]any{`)
+	assert.Contains(code, `func (msg) PostVerb(l *i18n.Locale) string {
+	return l.StringX("Post", "verb")
+}`)
+	assert.Contains(code, `func (msg) NoPost1PostPostsNoun(l *i18n.Locale, count int) string {
+	return l.NumberX("No Post | 1 Post | {{ .Count }} Posts", "noun", count, map[string]any{`)
+}
+
+func TestGenerateVariants(t *testing.T) {
+	assert := assert.New(t)
+
+	src, err := generate("msg", "Msg", false, map[string]string{
+		"profile_updated [female]": "{{ .Name }} 更新了她的個人檔案",
+		"profile_updated [other]":  "{{ .Name }} 更新了個人檔案",
+		"photos_added [female]":    "{{ .Name }} 新增了一張照片 | {{ .Name }} 新增了 {{ .Count }} 張照片",
+		"photos_added":             "新增了 {{ .Count }} 張照片",
+		"Invite <admin> [female]":  "邀請她成為管理員",
+		"Invite <admin> [other]":   "邀請成為管理員",
+	})
+	assert.NoError(err)
+
+	code := string(src)
+	assert.Contains(code, `func (msg) ProfileUpdated(l *i18n.Locale, value string, name any) string {
+	return l.Select("profile_updated", value, map[string]any{
+		"Name": name,
+	})
+}`)
+	assert.Contains(code, `func (msg) PhotosAdded(l *i18n.Locale, value string, count int, name any) string {
+	return l.SelectNumber("photos_added", value, count, map[string]any{
+		"Count": count,
+		"Name":  name,
+	})
+}`)
+	assert.Contains(code, `func (msg) InviteAdmin(l *i18n.Locale, value string) string {
+	return l.SelectX("Invite", "admin", value)
+}`)
+}
+
+func TestGenerateBraces(t *testing.T) {
+	assert := assert.New(t)
+
+	translations := map[string]string{
+		"test_brace":  "你好，{Name}！{ not a name }",
+		"test_plural": "沒有 | {Name} 有 1 個 | {Name} 有 {Count} 個",
+		"test_mixed":  "{Name} | {{ .Count }} {Unit}",
+	}
+	src, err := generate("msg", "Msg", true, translations)
+	assert.NoError(err)
+
+	code := string(src)
+	assert.Contains(code, `func (msg) TestBrace(l *i18n.Locale, name any) string {
+	return l.String("test_brace", map[string]any{
+		"Name": name,
+	})
+}`)
+	assert.Contains(code, `func (msg) TestPlural(l *i18n.Locale, count int, name any) string {
+	return l.Number("test_plural", count, map[string]any{
+		"Count": count,
+		"Name":  name,
+	})
+}`)
+	assert.Contains(code, `func (msg) TestMixed(l *i18n.Locale, count int, name any) string {`)
+
+	src, err = generate("msg", "Msg", false, translations)
+	assert.NoError(err)
+	assert.Contains(string(src), `func (msg) TestBrace(l *i18n.Locale) string {`)
+}
+
+func TestGenerateContexts(t *testing.T) {
+	assert := assert.New(t)
+
+	src, err := generate("msg", "Msg", false, map[string]string{
+		"a <b> <c>":       "A",
+		"greet [female]":  "Hi, {{ .Value }}",
+		"greet [b] [c]":   "Hey",
+		"Post <verb> [x]": "Post {{ .Value }}",
+	})
+	assert.NoError(err)
+
+	code := string(src)
+	assert.Contains(code, `return l.StringX("a <b>", "c")`)
+	assert.Contains(code, `func (msg) Greet(l *i18n.Locale, value string, value_ any) string {
+	return l.Select("greet", value, map[string]any{
+		"Value": value_,
+	})
+}`)
+	assert.Contains(code, `return l.Select("greet [b]", value)`)
+	assert.Contains(code, `return l.SelectX("Post", "verb", value, map[string]any{`)
+}
+
+func TestGenerateCompiles(t *testing.T) {
+	assert := assert.New(t)
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("the go command is not available")
+	}
+
+	src, err := generate("msg", "Msg", false, map[string]string{
+		"test_message":            "這是一則測試訊息。",
+		"test_plural":             "沒有 | 只有 1 個 | 有 {{ .Count }} 個",
+		"a <b> <c>":               "A",
+		"greet [female]":          "Hi, {{ .Value }} and {{ .L }}",
+		"greet [other]":           "Hello, {{ .Count }} {{ .I18n }} {{ .Type }}",
+		"photos <noun> [female]":  "{{ .Value }} | {{ .Count }} photos",
+		"photos <noun>":           "photo | photos",
+		"{{ .Count }} apples":     "{{ .Count }} apples | {{ .Count }} apples",
+		"Hello, {{ .Name }}!":     "Hello, {{ .Name }}!",
+		"Hello, {{ .Name }}!!":    "Hello, {{ .Name }}!!",
+		"test_range":              "{{ range .Users }}{{ .Name }}{{ $.Separator }}{{ end }}",
+		"test_escaped \\| <verb>": "A \\| B",
+	})
+	assert.NoError(err)
+
+	// The directory is ignored by `./...` since it starts with `_`.
+	dir, err := os.MkdirTemp(".", "_generated")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+	assert.NoError(os.WriteFile(filepath.Join(dir, "msg.go"), src, 0644))
+
+	out, err := exec.Command("go", "vet", "./"+filepath.ToSlash(dir)).CombinedOutput()
+	assert.NoError(err, string(out))
+}
+
+func TestReadFile(t *testing.T) {
+	assert := assert.New(t)
+
+	trans, err := readFile("../../test/formats/en-us.toml")
+	assert.NoError(err)
+	assert.Equal("No items | 1 item | {{ .Count }} items", trans["checkout.items"])
+
+	trans, err = readFile("../../test/formats/zh-tw.po")
+	assert.NoError(err)
+	assert.Equal("發佈", trans["Post <verb>"])
+
+	trans, err = readFile("../../test/formats/de.yml")
+	assert.NoError(err)
+	assert.Equal("Kasse", trans["title"])
+
+	trans, err = readFile("../../test/zh-tw.json")
+	assert.NoError(err)
+	assert.Equal("訊息 A", trans["message_a"])
+
+	_, err = readFile("../../go.mod")
+	assert.EqualError(err, `../../go.mod: no unmarshaler for the ".mod" extension`)
+}
+
+func TestGenerateInvalidTemplate(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := generate("msg", "Msg", false, map[string]string{
+		"test_template": "你好，{{ .Name ！",
+	})
+	assert.Error(err)
+}
+
+func TestIdentifier(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("TestPlural", identifier("test_plural", 0))
+	assert.Equal("HelloWorld", identifier("Hello, world!", 0))
+	assert.Equal("HowAreYou", identifier("How are you, {{ .Name }}?", 0))
+	assert.Equal("N404NotFound", identifier("404 not found", 0))
+	assert.Equal("Key3", identifier("你好", 3))
+	assert.Equal("type_", parameter("Type"))
+}
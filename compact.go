@@ -0,0 +1,169 @@
+package i18n
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// WithCompactLong spells the compact number magnitudes out (e.g. `1.2 thousand` instead of `1.2K`), works with `FormatCompact` only.
+func WithCompactLong() func(*NumberFormat) {
+	return func(f *NumberFormat) {
+		f.compactLong = true
+	}
+}
+
+// FormatCompact formats the number in the compact form of the locale (e.g. `1.2K`, `12萬`, `1,2 Mio.`),
+// the mantissa keeps 1 fraction digit if it has a single integer digit unless `WithFractionDigits` was used,
+// and it's never grouped like the CLDR compact patterns (e.g. `1234万`). The numbers that aren't compacted are grouped
+// if there are 2 digits or more in the first group (e.g. `1234` and `12.345` in German).
+//
+// The plural operand is returned as well, it's the rounded number that was displayed (e.g. `1200` for `1.2K`)
+// and can be passed to `Locale.Number` for choosing the plural form of the rest of the message.
+func (l *Locale) FormatCompact(v any, options ...func(*NumberFormat)) (string, int) {
+	f := newNumberFormat(options...)
+	d := lookupLocaleData(l.locale)
+	s := d.symbols(f.native)
+	n, ok := parseDecimal(v)
+	if !ok {
+		return fmt.Sprint(v), 0
+	}
+	if n.special != "" {
+		return formatDecimal(d, s, n, f), 0
+	}
+	patterns := lookupLocale(compactDatas, l.locale).short
+	if f.compactLong {
+		patterns = lookupLocale(compactDatas, l.locale).long
+	}
+
+	var (
+		pattern  compactPattern
+		mantissa decimal
+	)
+	for {
+		pattern = compactPattern{}
+		magnitude := len(strings.TrimLeft(n.integer, "0")) - 1
+		for _, p := range patterns {
+			if p.exponent <= magnitude {
+				pattern = p
+			}
+		}
+		mantissa = n.shift(-pattern.exponent)
+		min, max := 0, 0
+		if len(mantissa.integer) == 1 {
+			max = 1
+		}
+		if f.fractionDigits {
+			min, max = f.minFractionDigits, f.maxFractionDigits
+		}
+		mantissa = mantissa.round(min, max)
+		// The rounding might carry the number to the next magnitude (e.g. `999,999` to `1000K`),
+		// the magnitude will be chosen again with the rounded number.
+		rounded := mantissa.shift(pattern.exponent)
+		if len(strings.TrimLeft(rounded.integer, "0")) == len(strings.TrimLeft(n.integer, "0")) {
+			break
+		}
+		n = rounded
+	}
+
+	format := &NumberFormat{
+		minFractionDigits: len(mantissa.fraction),
+		maxFractionDigits: len(mantissa.fraction),
+	}
+	if pattern.pattern == "" {
+		format.grouping = f.grouping
+		d = d.derive(func(d *localeData) {
+			if d.minimumGrouping < 2 {
+				d.minimumGrouping = 2
+			}
+		})
+	}
+	text := formatDecimal(d, s, mantissa, format)
+	operand := mantissa.shift(pattern.exponent)
+	count, err := strconv.Atoi(operand.integer)
+	if err != nil {
+		count = math.MaxInt
+	}
+	if operand.negative {
+		count = -count
+	}
+	if pattern.pattern == "" {
+		return text, count
+	}
+	forms := strings.Split(pattern.pattern, " | ")
+	form := forms[len(forms)-1]
+	if strings.Trim(mantissa.fraction, "0") == "" {
+		mantissaCount, _ := strconv.Atoi(mantissa.integer)
+		form = forms[pluralIndex(l.parent.pluralizor(l.locale), mantissaCount, len(forms))]
+	}
+	return strings.Replace(form, "{0}", text, 1), count
+}
+
+// compactPattern is used for the numbers that have `exponent` or more integer digits after the first one,
+// the number is divided by the `exponent` power of 10 and formatted with the pattern (e.g. `{0}K`).
+// The pattern might contain the plural forms that were split by ` | `.
+type compactPattern struct {
+	exponent int
+	pattern  string
+}
+
+// compactData is the compact patterns of a locale, the patterns are sorted by the exponents.
+type compactData struct {
+	short []compactPattern
+	long  []compactPattern
+}
+
+var compactDatas = map[string]*compactData{
+	"en": {
+		short: []compactPattern{{3, "{0}K"}, {6, "{0}M"}, {9, "{0}B"}, {12, "{0}T"}},
+		long:  []compactPattern{{3, "{0} thousand"}, {6, "{0} million"}, {9, "{0} billion"}, {12, "{0} trillion"}},
+	},
+	"zh": {
+		short: []compactPattern{{4, "{0}万"}, {8, "{0}亿"}, {12, "{0}万亿"}},
+		long:  []compactPattern{{4, "{0}万"}, {8, "{0}亿"}, {12, "{0}万亿"}},
+	},
+	"zh-tw": {
+		short: []compactPattern{{4, "{0}萬"}, {8, "{0}億"}, {12, "{0}兆"}},
+		long:  []compactPattern{{4, "{0}萬"}, {8, "{0}億"}, {12, "{0}兆"}},
+	},
+	"ja": {
+		short: []compactPattern{{4, "{0}万"}, {8, "{0}億"}, {12, "{0}兆"}},
+		long:  []compactPattern{{4, "{0}万"}, {8, "{0}億"}, {12, "{0}兆"}},
+	},
+	"ko": {
+		short: []compactPattern{{3, "{0}천"}, {4, "{0}만"}, {8, "{0}억"}, {12, "{0}조"}},
+		long:  []compactPattern{{3, "{0}천"}, {4, "{0}만"}, {8, "{0}억"}, {12, "{0}조"}},
+	},
+	"de": {
+		short: []compactPattern{{6, "{0}" + nbsp + "Mio."}, {9, "{0}" + nbsp + "Mrd."}, {12, "{0}" + nbsp + "Bio."}},
+		long: []compactPattern{
+			{3, "{0} Tausend"},
+			{6, "{0} Millionen | {0} Million | {0} Millionen"},
+			{9, "{0} Milliarden | {0} Milliarde | {0} Milliarden"},
+			{12, "{0} Billionen | {0} Billion | {0} Billionen"},
+		},
+	},
+	"fr": {
+		short: []compactPattern{{3, "{0}" + nbsp + "k"}, {6, "{0}" + nbsp + "M"}, {9, "{0}" + nbsp + "Md"}, {12, "{0}" + nbsp + "Bn"}},
+		long: []compactPattern{
+			{3, "{0} mille"},
+			{6, "{0} million | {0} millions"},
+			{9, "{0} milliard | {0} milliards"},
+			{12, "{0} billion | {0} billions"},
+		},
+	},
+	"es": {
+		short: []compactPattern{{3, "{0}" + nbsp + "mil"}, {6, "{0}" + nbsp + "M"}, {9, "{0}" + nbsp + "mil" + nbsp + "M"}, {12, "{0}" + nbsp + "B"}},
+		long: []compactPattern{
+			{3, "{0} mil"},
+			{6, "{0} millones | {0} millón | {0} millones"},
+			{9, "{0} mil millones"},
+			{12, "{0} billones | {0} billón | {0} billones"},
+		},
+	},
+}
+
+func init() {
+	compactDatas["zh-hk"] = compactDatas["zh-tw"]
+}
@@ -0,0 +1,94 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatCompact(t *testing.T) {
+	assert := assert.New(t)
+
+	en := newFormatLocale("en-us")
+	tw := newFormatLocale("zh-tw")
+	de := newFormatLocale("de-de")
+	ja := newFormatLocale("ja-jp")
+
+	compact := func(l *Locale, v any, options ...func(*NumberFormat)) string {
+		text, _ := l.FormatCompact(v, options...)
+		return text
+	}
+	assert.Equal("999", compact(en, 999))
+	assert.Equal("1.2K", compact(en, 1234))
+	assert.Equal("12K", compact(en, 12345))
+	assert.Equal("123K", compact(en, 123456))
+	assert.Equal("1M", compact(en, 999999))
+	assert.Equal("1.5M", compact(en, 1500000))
+	assert.Equal("-1.2K", compact(en, -1234))
+	assert.Equal("1.5", compact(en, 1.54))
+	assert.Equal("1.23K", compact(en, 1234, WithFractionDigits(0, 2)))
+	assert.Equal("1000T", compact(en, int64(1000000000000000)))
+
+	// East Asian.
+	assert.Equal("1234", compact(tw, 1234))
+	assert.Equal("-1234", compact(tw, -1234))
+	assert.Equal("1.2萬", compact(tw, 12345))
+	assert.Equal("12萬", compact(tw, 123456))
+	assert.Equal("1.2億", compact(tw, 123456789))
+	assert.Equal("1234万", compact(ja, 12340000))
+
+	// Long.
+	assert.Equal("1.2 thousand", compact(en, 1234, WithCompactLong()))
+	assert.Equal("1,2\u00a0Mio.", compact(de, 1234567))
+	assert.Equal("1234", compact(de, 1234))
+	assert.Equal("12.345", compact(de, 12345))
+	assert.Equal("999.999", compact(de, 999999))
+	assert.Equal("12345", compact(de, 12345, WithoutGrouping()))
+	assert.Equal("1 Million", compact(de, 1000000, WithCompactLong()))
+	assert.Equal("2 Millionen", compact(de, 2000000, WithCompactLong()))
+	assert.Equal("1,2 Millionen", compact(de, 1234567, WithCompactLong()))
+}
+
+func TestFormatCompactOperand(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"test_followers": "No followers | {{ .Count }} follower | {{ .Count }} followers",
+		},
+	})
+	l := i.NewLocale("en-us")
+
+	text, count := l.FormatCompact(1234)
+	assert.Equal(1200, count)
+	assert.Equal("1.2K followers", l.Number("test_followers", count, map[string]any{"Count": text}))
+
+	text, count = l.FormatCompact(1)
+	assert.Equal(1, count)
+	assert.Equal("1 follower", l.Number("test_followers", count, map[string]any{"Count": text}))
+
+	_, count = l.FormatCompact(999999)
+	assert.Equal(1000000, count)
+	_, count = l.FormatCompact(-1234)
+	assert.Equal(-1200, count)
+}
+
+func TestCompactTemplateFuncs(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"test_views": "{{ compact .Views }} views ({{ compactLong .Views }})",
+		},
+		"zh-tw": map[string]string{
+			"test_views": "{{ compact .Views }} 次觀看",
+		},
+	})
+	data := map[string]any{
+		"Views": 123456,
+	}
+	assert.Equal("123K views (123 thousand)", i.NewLocale("en-us").String("test_views", data))
+	assert.Equal("12萬 次觀看", i.NewLocale("zh-tw").String("test_views", data))
+}
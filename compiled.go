@@ -0,0 +1,111 @@
+package i18n
+
+import (
+	"sort"
+	"strings"
+)
+
+// CompiledCatalog is a set of translations that was compiled at build time by the `i18nc` command,
+// the plural forms were split and the fallbacks were resolved so it can be loaded without parsing.
+type CompiledCatalog struct {
+	DefaultLocale string
+	Locales       []CompiledLocale
+}
+
+// CompiledLocale is the compiled translations of a locale.
+type CompiledLocale struct {
+	Locale   string
+	Messages []CompiledMessage
+}
+
+// CompiledMessage is a compiled translation, `Origin` is the locale that provides the translation when it's a fallback.
+type CompiledMessage struct {
+	Name   string
+	Origin string
+	Texts  []string
+}
+
+// Compile exports the loaded translations with the resolved fallbacks as a `CompiledCatalog`.
+func (i *I18n) Compile() *CompiledCatalog {
+	c := &CompiledCatalog{
+		DefaultLocale: i.defaultLocale,
+	}
+	for locale, translations := range i.compiled.Load().translations {
+		compLocale := CompiledLocale{
+			Locale: locale,
+		}
+		for name, trans := range translations {
+			msg := CompiledMessage{
+				Name:  name,
+				Texts: make([]string, len(trans.texts)),
+			}
+			if trans.locale != locale {
+				msg.Origin = trans.locale
+			}
+			for j, v := range trans.texts {
+				msg.Texts[j] = v.text
+			}
+			compLocale.Messages = append(compLocale.Messages, msg)
+		}
+		sort.Slice(compLocale.Messages, func(a, b int) bool {
+			return compLocale.Messages[a].Name < compLocale.Messages[b].Name
+		})
+		c.Locales = append(c.Locales, compLocale)
+	}
+	sort.Slice(c.Locales, func(a, b int) bool {
+		return c.Locales[a].Locale < c.Locales[b].Locale
+	})
+	return c
+}
+
+// LoadCompiled loads the translations from a `CompiledCatalog`,
+// the templates will be parsed lazily when they were used for the first time.
+func (i *I18n) LoadCompiled(c *CompiledCatalog) error {
+	return i.update(func(compiled *catalog) error {
+		i.loadCompiled(compiled, c)
+		return nil
+	})
+}
+
+// loadCompiled
+func (i *I18n) loadCompiled(compiled *catalog, c *CompiledCatalog) {
+	for _, compLocale := range c.Locales {
+		locale := nameInsenstive(compLocale.Locale)
+		translations := make(map[string]*compiledTranslation, len(compLocale.Messages))
+
+		var size int
+		for _, msg := range compLocale.Messages {
+			size += len(msg.Texts)
+		}
+		compTranslations := make([]compiledTranslation, len(compLocale.Messages))
+		compTexts := make([]compiledText, size)
+		texts := make([]*compiledText, size)
+
+		for j, msg := range compLocale.Messages {
+			origin := locale
+			if msg.Origin != "" {
+				origin = nameInsenstive(msg.Origin)
+			}
+			for k, v := range msg.Texts {
+				compTexts[k].text = v
+				compTexts[k].template = strings.Contains(v, "{{")
+				if !compTexts[k].template {
+					compTexts[k].placeholders = i.compilePlaceholders(v)
+				}
+				texts[k] = &compTexts[k]
+			}
+			compTranslations[j] = compiledTranslation{
+				locale:     origin,
+				name:       msg.Name,
+				pluralizor: i.pluralizor(origin),
+				texts:      texts[:len(msg.Texts):len(msg.Texts)],
+			}
+			translations[msg.Name] = &compTranslations[j]
+
+			compTexts = compTexts[len(msg.Texts):]
+			texts = texts[len(msg.Texts):]
+		}
+		compiled.translations[locale] = translations
+	}
+	i.compileContexts(compiled)
+}
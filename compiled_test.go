@@ -0,0 +1,95 @@
+package i18n
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadCompiled(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("zh-tw", WithFallback(map[string][]string{
+		"ja-jp": []string{"ko-kr"},
+	}))
+	i.LoadMap(testTranslations)
+	c := i.Compile()
+	assert.Equal("zh-tw", c.DefaultLocale)
+
+	j := New("zh-tw")
+	assert.NoError(j.LoadCompiled(c))
+	l := j.NewLocale("ja-jp")
+
+	assert.Equal("これはテストメッセージです。", l.String("test_message"))
+	assert.Equal("こんにちは、Yami！", l.String("test_template", map[string]string{
+		"Name": "Yami",
+	}))
+	assert.Equal("なし", l.Number("test_plural", 0))
+	assert.Equal("2 個あります", l.Number("test_plural", 2, map[string]int{
+		"Count": 2,
+	}))
+
+	// Pre-resolved ja-jp -> ko-kr fallback
+	assert.Equal("안녕하세요, 세상!", l.String("Hello, world!"))
+	assert.Equal("메시지 게시", l.StringX("Post", "verb"))
+
+	// Pre-resolved ja-jp -> zh-tw fallback
+	assert.Equal("有 2 顆蘋果", l.Number("None | 1 Apple | {{ .Count }} Apples", 2, map[string]int{
+		"Count": 2,
+	}))
+	assert.Equal("Ni hao", l.String("Ni hao"))
+}
+
+// newBenchmarkTranslations generates the translations with the specified amount of keys.
+func newBenchmarkTranslations(size int) map[string]map[string]string {
+	translations := make(map[string]map[string]string)
+	for _, locale := range []string{"zh-tw", "en-us", "ja-jp"} {
+		translations[locale] = make(map[string]string)
+		for j := 0; j < size; j++ {
+			switch j % 3 {
+			case 0:
+				translations[locale][fmt.Sprintf("message_%d", j)] = fmt.Sprintf("%s message %d", locale, j)
+			case 1:
+				translations[locale][fmt.Sprintf("message_%d", j)] = fmt.Sprintf("%s hello, {{ .Name }} %d", locale, j)
+			case 2:
+				translations[locale][fmt.Sprintf("message_%d", j)] = fmt.Sprintf("None | One %d | {{ .Count }} %s", j, locale)
+			}
+		}
+	}
+	return translations
+}
+
+func BenchmarkLoadFS(b *testing.B) {
+	fsys := make(fstest.MapFS)
+	for locale, translations := range newBenchmarkTranslations(10000) {
+		data, _ := json.Marshal(translations)
+		fsys[locale+".json"] = &fstest.MapFile{Data: data}
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		i := New("zh-tw")
+		if err := i.LoadFS(fsys, "*.json"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkLoadCompiled(b *testing.B) {
+	i := New("zh-tw")
+	i.LoadMap(newBenchmarkTranslations(10000))
+	c := i.Compile()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		i := New("zh-tw")
+		if err := i.LoadCompiled(c); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package i18n
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CurrencyDisplay decides how the currency is displayed.
+type CurrencyDisplay int
+
+const (
+	// CurrencySymbol displays the currency as the symbol of the locale (e.g. `NT$`, `$`).
+	CurrencySymbol CurrencyDisplay = iota
+	// CurrencyNarrowSymbol displays the currency as the narrow symbol (e.g. `$`).
+	CurrencyNarrowSymbol
+	// CurrencyCode displays the currency as the ISO 4217 code (e.g. `TWD`).
+	CurrencyCode
+)
+
+// WithCurrencyDisplay changes how the currency is displayed, defaults to `CurrencySymbol`.
+func WithCurrencyDisplay(d CurrencyDisplay) func(*NumberFormat) {
+	return func(f *NumberFormat) {
+		f.currencyDisplay = d
+	}
+}
+
+// WithAccounting formats the negative amounts in the accounting style of the locale (e.g. `($1,200.00)`).
+func WithAccounting() func(*NumberFormat) {
+	return func(f *NumberFormat) {
+		f.accounting = true
+	}
+}
+
+// WithCashDigits uses the fraction digits of the cash (e.g. `NT$1,200` instead of `NT$1,200.00`).
+func WithCashDigits() func(*NumberFormat) {
+	return func(f *NumberFormat) {
+		f.cash = true
+	}
+}
+
+// FormatCurrency formats an amount of the ISO 4217 currency (e.g. `TWD`, `EUR`) with the conventions of the locale,
+// such as `NT$1,200.00` or `1 200,00 €`. The fraction digits follow the currency unless `WithFractionDigits` was used.
+func (l *Locale) FormatCurrency(v any, currency string, options ...func(*NumberFormat)) string {
+	f := newNumberFormat(options...)
+	d := lookupLocaleData(l.locale)
+	s := d.symbols(f.native)
+	code := strings.ToUpper(currency)
+
+	info, ok := currencies[code]
+	if !ok {
+		info.digits, info.cashDigits, info.symbol, info.narrowSymbol = 2, 2, code, code
+	}
+	if !f.fractionDigits {
+		f.minFractionDigits, f.maxFractionDigits = info.digits, info.digits
+		if f.cash {
+			f.minFractionDigits, f.maxFractionDigits = info.cashDigits, info.cashDigits
+		}
+	}
+
+	n, ok := parseDecimal(v)
+	if !ok {
+		return fmt.Sprint(v)
+	}
+	n = n.round(f.minFractionDigits, f.maxFractionDigits)
+	negative := n.negative
+	n.negative = false
+	text := formatDecimal(d, s, n, f)
+
+	var symbol string
+	switch f.currencyDisplay {
+	case CurrencyCode:
+		symbol = code
+	case CurrencyNarrowSymbol:
+		symbol = info.narrowSymbol
+	default:
+		symbol = info.symbol
+		if v, ok := d.currencySymbols[code]; ok {
+			symbol = v
+		}
+	}
+
+	pattern := d.currency
+	if negative && f.accounting && d.accounting != "" {
+		pattern, negative = d.accounting, false
+	}
+	text = applyCurrencyPattern(pattern, symbol, text)
+	if negative {
+		return s.minus + text
+	}
+	return text
+}
+
+// applyCurrencyPattern replaces the `¤` with the symbol and the `{0}` with the number,
+// a no-break space will be inserted if the symbol is a word that sticks to the number (e.g. `CHF 12.00`).
+func applyCurrencyPattern(pattern, symbol, number string) string {
+	if strings.Contains(pattern, "¤{0}") {
+		if r, _ := utf8.DecodeLastRuneInString(symbol); unicode.IsLetter(r) {
+			symbol += nbsp
+		}
+	}
+	if strings.Contains(pattern, "{0}¤") {
+		if r, _ := utf8.DecodeRuneInString(symbol); unicode.IsLetter(r) {
+			symbol = nbsp + symbol
+		}
+	}
+	return strings.NewReplacer("¤", symbol, "{0}", number).Replace(pattern)
+}
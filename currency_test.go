@@ -0,0 +1,62 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatCurrency(t *testing.T) {
+	assert := assert.New(t)
+
+	en := newFormatLocale("en-us")
+	tw := newFormatLocale("zh-tw")
+	fr := newFormatLocale("fr-fr")
+	de := newFormatLocale("de-de")
+	ja := newFormatLocale("ja-jp")
+
+	assert.Equal("$1,200.00", en.FormatCurrency(1200, "USD"))
+	assert.Equal("NT$1,200.00", en.FormatCurrency(1200, "TWD"))
+	assert.Equal("NT$1,200", en.FormatCurrency(1200, "twd", WithCashDigits()))
+	assert.Equal("$1,200.00", tw.FormatCurrency(1200, "TWD"))
+	assert.Equal("US$1,200.00", tw.FormatCurrency(1200, "USD"))
+	assert.Equal("1\u202f200,00\u00a0€", fr.FormatCurrency(1200, "EUR"))
+	assert.Equal("1.234,57\u00a0€", de.FormatCurrency(1234.567, "EUR"))
+	assert.Equal("￥1,235", ja.FormatCurrency(1234.6, "JPY"))
+	assert.Equal("BHD\u00a01,234.568", en.FormatCurrency(1234.5678, "BHD"))
+
+	// Display.
+	assert.Equal("$1,200.00", en.FormatCurrency(1200, "TWD", WithCurrencyDisplay(CurrencyNarrowSymbol)))
+	assert.Equal("TWD\u00a01,200.00", en.FormatCurrency(1200, "TWD", WithCurrencyDisplay(CurrencyCode)))
+	assert.Equal("1.200,00\u00a0USD", de.FormatCurrency(1200, "USD", WithCurrencyDisplay(CurrencyCode)))
+	assert.Equal("XYZ\u00a01.50", en.FormatCurrency(1.5, "XYZ"))
+
+	// Negative and accounting.
+	assert.Equal("-$1,200.00", en.FormatCurrency(-1200, "USD"))
+	assert.Equal("($1,200.00)", en.FormatCurrency(-1200, "USD", WithAccounting()))
+	assert.Equal("-1.200,00\u00a0€", de.FormatCurrency(-1200, "EUR", WithAccounting()))
+	assert.Equal("$0.00", en.FormatCurrency(-0.001, "USD", WithAccounting()))
+
+	// Fraction digits.
+	assert.Equal("$1,200", en.FormatCurrency(1200, "USD", WithFractionDigits(0, 0)))
+}
+
+func TestCurrencyTemplateFunc(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"test_price": "Total: {{ currency .Price .Currency }}",
+		},
+		"fr-fr": map[string]string{
+			"test_price": "Total : {{ currency .Price .Currency }}",
+		},
+	})
+	data := map[string]any{
+		"Price":    1200,
+		"Currency": "EUR",
+	}
+	assert.Equal("Total: €1,200.00", i.NewLocale("en-us").String("test_price", data))
+	assert.Equal("Total : 1\u202f200,00\u00a0€", i.NewLocale("fr-fr").String("test_price", data))
+}
@@ -0,0 +1,500 @@
+package i18n
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// DateStyle is the length of a formatted date or time.
+type DateStyle int
+
+const (
+	// DateShort is the numeric style like `10/16/26` and `3:04 PM`.
+	DateShort DateStyle = iota
+	// DateMedium is the abbreviated style like `Oct 16, 2026` and `3:04:05 PM`.
+	DateMedium
+	// DateLong is the long style like `October 16, 2026` and `3:04:05 PM CST`.
+	DateLong
+	// DateFull is the complete style like `Friday, October 16, 2026` and `3:04:05 PM GMT+08:00`.
+	DateFull
+)
+
+// dateStyles maps the style names that were used in the templates.
+var dateStyles = map[string]DateStyle{
+	"short":  DateShort,
+	"medium": DateMedium,
+	"long":   DateLong,
+	"full":   DateFull,
+}
+
+// DateFormat describes how a date or a time should be formatted.
+type DateFormat struct {
+	location  *time.Location
+	hourCycle int
+}
+
+// WithTimeZone converts the time to the location before formatting.
+func WithTimeZone(loc *time.Location) func(*DateFormat) {
+	return func(f *DateFormat) {
+		f.location = loc
+	}
+}
+
+// WithHour12 uses the 12-hour clock (e.g. `3:04 PM`) regardless of the preference of the locale.
+func WithHour12() func(*DateFormat) {
+	return func(f *DateFormat) {
+		f.hourCycle = 12
+	}
+}
+
+// WithHour24 uses the 24-hour clock (e.g. `15:04`) regardless of the preference of the locale.
+func WithHour24() func(*DateFormat) {
+	return func(f *DateFormat) {
+		f.hourCycle = 24
+	}
+}
+
+// newDateFormat
+func newDateFormat(t time.Time, options ...func(*DateFormat)) (*DateFormat, time.Time) {
+	f := &DateFormat{}
+	for _, o := range options {
+		o(f)
+	}
+	if f.location != nil {
+		t = t.In(f.location)
+	}
+	return f, t
+}
+
+// FormatDate formats the date with the style of the locale, such as `Oct 16, 2026` or `2026年10月16日`.
+func (l *Locale) FormatDate(t time.Time, style DateStyle, options ...func(*DateFormat)) string {
+	d := lookupLocale(dateDatas, l.locale)
+	_, t = newDateFormat(t, options...)
+	return d.format(d.dates[style], t)
+}
+
+// FormatTime formats the time with the style of the locale, such as `3:04 PM` or `15:04`.
+func (l *Locale) FormatTime(t time.Time, style DateStyle, options ...func(*DateFormat)) string {
+	d := lookupLocale(dateDatas, l.locale)
+	f, t := newDateFormat(t, options...)
+	return d.format(d.timePattern(style, f), t)
+}
+
+// FormatDateTime formats the date and the time with the styles of the locale, such as `Oct 16, 2026, 3:04 PM`.
+func (l *Locale) FormatDateTime(t time.Time, dateStyle, timeStyle DateStyle, options ...func(*DateFormat)) string {
+	d := lookupLocale(dateDatas, l.locale)
+	f, t := newDateFormat(t, options...)
+	return d.format(d.combine(dateStyle, d.dates[dateStyle], d.timePattern(timeStyle, f)), t)
+}
+
+// FormatSkeleton formats the time with a CLDR skeleton that lists the fields without the order and the punctuations,
+// such as `yMMMd` (`Oct 16, 2026`, `2026年10月16日`) or `Hm` (`15:04`). The `j` stands for the preferred hour of the locale.
+func (l *Locale) FormatSkeleton(t time.Time, skeleton string, options ...func(*DateFormat)) string {
+	d := lookupLocale(dateDatas, l.locale)
+	f, t := newDateFormat(t, options...)
+	return d.format(d.skeletonPattern(skeleton, f), t)
+}
+
+// dateData is the calendar data of a locale.
+type dateData struct {
+	months        [12]string
+	monthsShort   [12]string
+	weekdays      [7]string
+	weekdaysShort [7]string
+	am            string
+	pm            string
+	hour12        bool
+	dates         [4]string
+	times         [4]string
+	dateTimes     [4]string
+	skeletons     map[string]string
+}
+
+// hour returns the hour field of the skeletons by the hour cycle.
+func (d *dateData) hour(f *DateFormat) string {
+	switch {
+	case f.hourCycle == 12, f.hourCycle == 0 && d.hour12:
+		return "h"
+	default:
+		return "H"
+	}
+}
+
+// timePattern returns the time pattern of the style, the pattern will be generated from the skeletons
+// if the hour cycle was different from the preference of the locale.
+func (d *dateData) timePattern(style DateStyle, f *DateFormat) string {
+	if f.hourCycle == 0 || (f.hourCycle == 12) == d.hour12 {
+		return d.times[style]
+	}
+	skeleton := d.hour(f) + "m"
+	if style != DateShort {
+		skeleton += "s"
+	}
+	switch style {
+	case DateLong:
+		skeleton += "z"
+	case DateFull:
+		skeleton += "zzzz"
+	}
+	return d.skeletonPattern(skeleton, f)
+}
+
+// combine glues the date and the time patterns.
+func (d *dateData) combine(style DateStyle, date, time string) string {
+	return strings.NewReplacer("{1}", date, "{0}", time).Replace(d.dateTimes[style])
+}
+
+// skeletonPattern finds the pattern of the skeleton, the date and the time fields will be looked up
+// separately and combined if the skeleton was not found.
+func (d *dateData) skeletonPattern(skeleton string, f *DateFormat) string {
+	hour := d.hour(f)
+	if f.hourCycle != 0 {
+		skeleton = strings.NewReplacer("j", hour, "h", hour, "H", hour, "K", hour, "k", hour).Replace(skeleton)
+	} else {
+		skeleton = strings.NewReplacer("j", hour, "K", "h", "k", "H").Replace(skeleton)
+	}
+
+	var zone string
+	if j := strings.IndexAny(skeleton, "zv"); j != -1 {
+		skeleton, zone = skeleton[:j], skeleton[j:]
+	}
+	if p, ok := d.skeletons[skeleton]; ok {
+		if zone != "" {
+			return p + " " + zone
+		}
+		return p
+	}
+	j := strings.IndexAny(skeleton, "hHms")
+	switch {
+	case j == 0:
+		p := d.times[DateMedium]
+		if v, ok := d.skeletons[skeleton[:1]+"ms"]; ok {
+			p = v
+		}
+		if zone != "" {
+			return p + " " + zone
+		}
+		return p
+	case j > 0:
+		return d.combine(DateMedium, d.skeletonPattern(skeleton[:j], f), d.skeletonPattern(skeleton[j:]+zone, f))
+	default:
+		return d.dates[DateMedium]
+	}
+}
+
+// format formats the time with a CLDR pattern like `MMM d, y`, the texts in the quotes are literals.
+func (d *dateData) format(pattern string, t time.Time) string {
+	var b strings.Builder
+	for j := 0; j < len(pattern); {
+		c := pattern[j]
+		switch {
+		case c == '\'':
+			if j+1 < len(pattern) && pattern[j+1] == '\'' {
+				b.WriteByte('\'')
+				j += 2
+				continue
+			}
+			end := strings.IndexByte(pattern[j+1:], '\'')
+			if end == -1 {
+				b.WriteString(pattern[j+1:])
+				return b.String()
+			}
+			b.WriteString(pattern[j+1 : j+1+end])
+			j += end + 2
+		case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+			k := j
+			for k < len(pattern) && pattern[k] == c {
+				k++
+			}
+			b.WriteString(d.field(c, k-j, t))
+			j = k
+		default:
+			_, size := utf8.DecodeRuneInString(pattern[j:])
+			b.WriteString(pattern[j : j+size])
+			j += size
+		}
+	}
+	return b.String()
+}
+
+// field formats a field of the pattern, the `n` is the length of the field (e.g. `MMM` is 3).
+func (d *dateData) field(c byte, n int, t time.Time) string {
+	switch c {
+	case 'y':
+		if n == 2 {
+			return fmt.Sprintf("%02d", t.Year()%100)
+		}
+		return fmt.Sprintf("%0*d", n, t.Year())
+	case 'M', 'L':
+		switch n {
+		case 1, 2:
+			return fmt.Sprintf("%0*d", n, int(t.Month()))
+		case 3:
+			return d.monthsShort[t.Month()-1]
+		case 4:
+			return d.months[t.Month()-1]
+		default:
+			r, _ := utf8.DecodeRuneInString(d.months[t.Month()-1])
+			return string(r)
+		}
+	case 'd':
+		return fmt.Sprintf("%0*d", n, t.Day())
+	case 'E', 'c', 'e':
+		switch {
+		case n <= 3:
+			return d.weekdaysShort[t.Weekday()]
+		case n == 4:
+			return d.weekdays[t.Weekday()]
+		default:
+			r, _ := utf8.DecodeRuneInString(d.weekdays[t.Weekday()])
+			return string(r)
+		}
+	case 'a', 'b', 'B':
+		if t.Hour() < 12 {
+			return d.am
+		}
+		return d.pm
+	case 'h':
+		h := t.Hour() % 12
+		if h == 0 {
+			h = 12
+		}
+		return fmt.Sprintf("%0*d", n, h)
+	case 'H':
+		return fmt.Sprintf("%0*d", n, t.Hour())
+	case 'K':
+		return fmt.Sprintf("%0*d", n, t.Hour()%12)
+	case 'k':
+		h := t.Hour()
+		if h == 0 {
+			h = 24
+		}
+		return fmt.Sprintf("%0*d", n, h)
+	case 'm':
+		return fmt.Sprintf("%0*d", n, t.Minute())
+	case 's':
+		return fmt.Sprintf("%0*d", n, t.Second())
+	case 'S':
+		if n > 9 {
+			n = 9
+		}
+		return fmt.Sprintf("%09d", t.Nanosecond())[:n]
+	case 'z', 'v':
+		name, offset := t.Zone()
+		if n < 4 && name != "" && !strings.ContainsAny(name, "+-0123456789") {
+			return name
+		}
+		return gmtOffset(offset, true)
+	case 'O':
+		_, offset := t.Zone()
+		return gmtOffset(offset, n == 4)
+	case 'Z', 'x', 'X':
+		_, offset := t.Zone()
+		if c == 'X' && offset == 0 {
+			return "Z"
+		}
+		v := gmtOffset(offset, true)
+		if v == "GMT" {
+			v = "GMT+00:00"
+		}
+		v = strings.TrimPrefix(v, "GMT")
+		if n < 3 || (c == 'Z' && n < 5) {
+			return strings.ReplaceAll(v, ":", "")
+		}
+		return v
+	case 'V':
+		return t.Location().String()
+	}
+	return strings.Repeat(string(c), n)
+}
+
+// gmtOffset formats the offset like `GMT+8` or `GMT+08:00`.
+func gmtOffset(offset int, long bool) string {
+	if offset == 0 {
+		return "GMT"
+	}
+	sign := "+"
+	if offset < 0 {
+		sign, offset = "-", -offset
+	}
+	hours, minutes := offset/3600, offset%3600/60
+	if long {
+		return fmt.Sprintf("GMT%s%02d:%02d", sign, hours, minutes)
+	}
+	if minutes != 0 {
+		return fmt.Sprintf("GMT%s%d:%02d", sign, hours, minutes)
+	}
+	return "GMT" + sign + strconv.Itoa(hours)
+}
+
+// dateDatas is the supported calendar data, keyed by the languages or the `language-region`.
+var dateDatas = map[string]*dateData{
+	"en": {
+		months:        [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		monthsShort:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		weekdays:      [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		weekdaysShort: [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		am:            "AM",
+		pm:            "PM",
+		hour12:        true,
+		dates:         [4]string{"M/d/yy", "MMM d, y", "MMMM d, y", "EEEE, MMMM d, y"},
+		times:         [4]string{"h:mm a", "h:mm:ss a", "h:mm:ss a z", "h:mm:ss a zzzz"},
+		dateTimes:     [4]string{"{1}, {0}", "{1}, {0}", "{1} 'at' {0}", "{1} 'at' {0}"},
+		skeletons: map[string]string{
+			"y": "y", "yM": "M/y", "yMd": "M/d/y", "yMMM": "MMM y", "yMMMM": "MMMM y", "yMMMd": "MMM d, y", "yMMMMd": "MMMM d, y", "yMMMEd": "EEE, MMM d, y",
+			"M": "L", "Md": "M/d", "MEd": "EEE, M/d", "MMM": "LLL", "MMMd": "MMM d", "MMMMd": "MMMM d", "MMMEd": "EEE, MMM d", "d": "d", "E": "ccc", "Ed": "d EEE",
+			"h": "h a", "H": "HH", "hm": "h:mm a", "Hm": "HH:mm", "hms": "h:mm:ss a", "Hms": "HH:mm:ss", "ms": "mm:ss",
+		},
+	},
+	"zh": {
+		months:        [12]string{"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+		monthsShort:   [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		weekdays:      [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+		weekdaysShort: [7]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"},
+		am:            "上午",
+		pm:            "下午",
+		hour12:        false,
+		dates:         [4]string{"y/M/d", "y年M月d日", "y年M月d日", "y年M月d日EEEE"},
+		times:         [4]string{"HH:mm", "HH:mm:ss", "z HH:mm:ss", "zzzz HH:mm:ss"},
+		dateTimes:     [4]string{"{1} {0}", "{1} {0}", "{1} {0}", "{1} {0}"},
+		skeletons: map[string]string{
+			"y": "y年", "yM": "y/M", "yMd": "y/M/d", "yMMM": "y年M月", "yMMMM": "y年M月", "yMMMd": "y年M月d日", "yMMMMd": "y年M月d日", "yMMMEd": "y年M月d日E",
+			"M": "M月", "Md": "M/d", "MEd": "M/dE", "MMM": "LLL", "MMMd": "M月d日", "MMMMd": "M月d日", "MMMEd": "M月d日E", "d": "d日", "E": "ccc", "Ed": "d日E",
+			"h": "ah时", "H": "H时", "hm": "ah:mm", "Hm": "HH:mm", "hms": "ah:mm:ss", "Hms": "HH:mm:ss", "ms": "mm:ss",
+		},
+	},
+	"ja": {
+		months:        [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		monthsShort:   [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		weekdays:      [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+		weekdaysShort: [7]string{"日", "月", "火", "水", "木", "金", "土"},
+		am:            "午前",
+		pm:            "午後",
+		hour12:        false,
+		dates:         [4]string{"y/MM/dd", "y/MM/dd", "y年M月d日", "y年M月d日EEEE"},
+		times:         [4]string{"H:mm", "H:mm:ss", "H:mm:ss z", "H時mm分ss秒 zzzz"},
+		dateTimes:     [4]string{"{1} {0}", "{1} {0}", "{1} {0}", "{1} {0}"},
+		skeletons: map[string]string{
+			"y": "y年", "yM": "y/M", "yMd": "y/M/d", "yMMM": "y年M月", "yMMMM": "y年M月", "yMMMd": "y年M月d日", "yMMMMd": "y年M月d日", "yMMMEd": "y年M月d日(E)",
+			"M": "M月", "Md": "M/d", "MEd": "M/d(E)", "MMM": "M月", "MMMd": "M月d日", "MMMMd": "M月d日", "MMMEd": "M月d日(E)", "d": "d日", "E": "ccc", "Ed": "d日(E)",
+			"h": "aK時", "H": "H時", "hm": "aK:mm", "Hm": "H:mm", "hms": "aK:mm:ss", "Hms": "H:mm:ss", "ms": "mm:ss",
+		},
+	},
+	"ko": {
+		months:        [12]string{"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"},
+		monthsShort:   [12]string{"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"},
+		weekdays:      [7]string{"일요일", "월요일", "화요일", "수요일", "목요일", "금요일", "토요일"},
+		weekdaysShort: [7]string{"일", "월", "화", "수", "목", "금", "토"},
+		am:            "오전",
+		pm:            "오후",
+		hour12:        true,
+		dates:         [4]string{"yy. M. d.", "y. M. d.", "y년 MMMM d일", "y년 MMMM d일 EEEE"},
+		times:         [4]string{"a h:mm", "a h:mm:ss", "a h시 m분 s초 z", "a h시 m분 s초 zzzz"},
+		dateTimes:     [4]string{"{1} {0}", "{1} {0}", "{1} {0}", "{1} {0}"},
+		skeletons: map[string]string{
+			"y": "y년", "yM": "y. M.", "yMd": "y. M. d.", "yMMM": "y년 MMM", "yMMMM": "y년 MMMM", "yMMMd": "y년 MMM d일", "yMMMMd": "y년 MMMM d일", "yMMMEd": "y년 MMM d일 (E)",
+			"M": "M월", "Md": "M. d.", "MEd": "M. d. (E)", "MMM": "LLL", "MMMd": "MMM d일", "MMMMd": "MMMM d일", "MMMEd": "MMM d일 (E)", "d": "d일", "E": "ccc", "Ed": "d일 (E)",
+			"h": "a h시", "H": "H시", "hm": "a h:mm", "Hm": "HH:mm", "hms": "a h:mm:ss", "Hms": "H시 m분 s초", "ms": "mm:ss",
+		},
+	},
+	"de": {
+		months:        [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		monthsShort:   [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+		weekdays:      [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		weekdaysShort: [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+		am:            "AM",
+		pm:            "PM",
+		hour12:        false,
+		dates:         [4]string{"dd.MM.yy", "dd.MM.y", "d. MMMM y", "EEEE, d. MMMM y"},
+		times:         [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss zzzz"},
+		dateTimes:     [4]string{"{1}, {0}", "{1}, {0}", "{1} 'um' {0}", "{1} 'um' {0}"},
+		skeletons: map[string]string{
+			"y": "y", "yM": "M/y", "yMd": "d.M.y", "yMMM": "MMM y", "yMMMM": "MMMM y", "yMMMd": "d. MMM y", "yMMMMd": "d. MMMM y", "yMMMEd": "E, d. MMM y",
+			"M": "L", "Md": "d.M.", "MEd": "E, d.M.", "MMM": "LLL", "MMMd": "d. MMM", "MMMMd": "d. MMMM", "MMMEd": "E, d. MMM", "d": "d", "E": "ccc", "Ed": "E, d.",
+			"h": "h 'Uhr' a", "H": "HH 'Uhr'", "hm": "h:mm a", "Hm": "HH:mm", "hms": "h:mm:ss a", "Hms": "HH:mm:ss", "ms": "mm:ss",
+		},
+	},
+	"fr": {
+		months:        [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		monthsShort:   [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		weekdays:      [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		weekdaysShort: [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		am:            "AM",
+		pm:            "PM",
+		hour12:        false,
+		dates:         [4]string{"dd/MM/y", "d MMM y", "d MMMM y", "EEEE d MMMM y"},
+		times:         [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss zzzz"},
+		dateTimes:     [4]string{"{1} {0}", "{1}, {0}", "{1} 'à' {0}", "{1} 'à' {0}"},
+		skeletons: map[string]string{
+			"y": "y", "yM": "MM/y", "yMd": "dd/MM/y", "yMMM": "MMM y", "yMMMM": "MMMM y", "yMMMd": "d MMM y", "yMMMMd": "d MMMM y", "yMMMEd": "E d MMM y",
+			"M": "L", "Md": "dd/MM", "MEd": "E dd/MM", "MMM": "LLL", "MMMd": "d MMM", "MMMMd": "d MMMM", "MMMEd": "E d MMM", "d": "d", "E": "E", "Ed": "E d",
+			"h": "h a", "H": "HH 'h'", "hm": "h:mm a", "Hm": "HH:mm", "hms": "h:mm:ss a", "Hms": "HH:mm:ss", "ms": "mm:ss",
+		},
+	},
+	"es": {
+		months:        [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		monthsShort:   [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+		weekdays:      [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		weekdaysShort: [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+		am:            "a." + nbsp + "m.",
+		pm:            "p." + nbsp + "m.",
+		hour12:        false,
+		dates:         [4]string{"d/M/yy", "d MMM y", "d 'de' MMMM 'de' y", "EEEE, d 'de' MMMM 'de' y"},
+		times:         [4]string{"H:mm", "H:mm:ss", "H:mm:ss z", "H:mm:ss (zzzz)"},
+		dateTimes:     [4]string{"{1}, {0}", "{1}, {0}", "{1}, {0}", "{1}, {0}"},
+		skeletons: map[string]string{
+			"y": "y", "yM": "M/y", "yMd": "d/M/y", "yMMM": "MMM y", "yMMMM": "MMMM 'de' y", "yMMMd": "d MMM y", "yMMMMd": "d 'de' MMMM 'de' y", "yMMMEd": "EEE, d MMM y",
+			"M": "L", "Md": "d/M", "MEd": "E, d/M", "MMM": "LLL", "MMMd": "d MMM", "MMMMd": "d 'de' MMMM", "MMMEd": "E, d MMM", "d": "d", "E": "ccc", "Ed": "E d",
+			"h": "h a", "H": "H", "hm": "h:mm a", "Hm": "H:mm", "hms": "h:mm:ss a", "Hms": "H:mm:ss", "ms": "mm:ss",
+		},
+	},
+}
+
+func init() {
+	dateDatas["en-gb"] = dateDatas["en"].derive(func(d *dateData) {
+		d.hour12 = false
+		d.dates = [4]string{"dd/MM/y", "d MMM y", "d MMMM y", "EEEE d MMMM y"}
+		d.times = [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss zzzz"}
+		d.skeletons = mergeSkeletons(d.skeletons, map[string]string{
+			"yM": "MM/y", "yMd": "dd/MM/y", "yMMMd": "d MMM y", "yMMMMd": "d MMMM y", "yMMMEd": "EEE, d MMM y",
+			"Md": "dd/MM", "MEd": "EEE dd/MM", "MMMd": "d MMM", "MMMMd": "d MMMM", "MMMEd": "EEE d MMM", "Ed": "EEE d",
+		})
+	})
+	dateDatas["zh-tw"] = dateDatas["zh"].derive(func(d *dateData) {
+		d.weekdaysShort = [7]string{"週日", "週一", "週二", "週三", "週四", "週五", "週六"}
+		d.hour12 = true
+		d.dates = [4]string{"y/M/d", "y年M月d日", "y年M月d日", "y年M月d日 EEEE"}
+		d.times = [4]string{"ah:mm", "ah:mm:ss", "ah:mm:ss [z]", "ah:mm:ss [zzzz]"}
+		d.skeletons = mergeSkeletons(d.skeletons, map[string]string{
+			"yMMMEd": "y年M月d日 E", "MEd": "M/d（E）", "MMMEd": "M月d日 E", "Ed": "d E", "h": "ah時", "H": "H時",
+		})
+	})
+	dateDatas["zh-hk"] = dateDatas["zh-tw"].derive(func(d *dateData) {
+		d.dates = [4]string{"d/M/y", "y年M月d日", "y年M月d日", "y年M月d日EEEE"}
+		d.skeletons = mergeSkeletons(d.skeletons, map[string]string{
+			"yM": "M/y", "yMd": "d/M/y", "Md": "d/M", "MEd": "d/M（E）",
+		})
+	})
+}
+
+// derive copies the calendar data and modifies it by the function.
+func (d *dateData) derive(fn func(*dateData)) *dateData {
+	v := *d
+	fn(&v)
+	return &v
+}
+
+// mergeSkeletons copies the skeletons and overrides them.
+func mergeSkeletons(skeletons, overrides map[string]string) map[string]string {
+	v := make(map[string]string, len(skeletons))
+	for k, p := range skeletons {
+		v[k] = p
+	}
+	for k, p := range overrides {
+		v[k] = p
+	}
+	return v
+}
@@ -0,0 +1,112 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatDate(t *testing.T) {
+	assert := assert.New(t)
+
+	taipei := time.FixedZone("CST", 8*60*60)
+	v := time.Date(2026, time.October, 16, 15, 4, 5, 0, taipei)
+
+	en := newFormatLocale("en-us")
+	assert.Equal("10/16/26", en.FormatDate(v, DateShort))
+	assert.Equal("Oct 16, 2026", en.FormatDate(v, DateMedium))
+	assert.Equal("October 16, 2026", en.FormatDate(v, DateLong))
+	assert.Equal("Friday, October 16, 2026", en.FormatDate(v, DateFull))
+
+	tw := newFormatLocale("zh-tw")
+	assert.Equal("2026/10/16", tw.FormatDate(v, DateShort))
+	assert.Equal("2026年10月16日 星期五", tw.FormatDate(v, DateFull))
+
+	assert.Equal("16.10.2026", newFormatLocale("de-de").FormatDate(v, DateMedium))
+	assert.Equal("vendredi 16 octobre 2026", newFormatLocale("fr-fr").FormatDate(v, DateFull))
+	assert.Equal("viernes, 16 de octubre de 2026", newFormatLocale("es-es").FormatDate(v, DateFull))
+	assert.Equal("2026年10月16日金曜日", newFormatLocale("ja-jp").FormatDate(v, DateFull))
+	assert.Equal("16/10/2026", newFormatLocale("en-gb").FormatDate(v, DateShort))
+
+	// Time zone.
+	assert.Equal("Oct 15, 2026", en.FormatDate(v, DateMedium, WithTimeZone(time.FixedZone("", -10*60*60))))
+}
+
+func TestFormatTime(t *testing.T) {
+	assert := assert.New(t)
+
+	v := time.Date(2026, time.October, 16, 15, 4, 5, 0, time.FixedZone("CST", 8*60*60))
+	en := newFormatLocale("en-us")
+	tw := newFormatLocale("zh-tw")
+	de := newFormatLocale("de-de")
+
+	assert.Equal("3:04 PM", en.FormatTime(v, DateShort))
+	assert.Equal("3:04:05 PM", en.FormatTime(v, DateMedium))
+	assert.Equal("3:04:05 PM CST", en.FormatTime(v, DateLong))
+	assert.Equal("3:04:05 PM GMT+08:00", en.FormatTime(v, DateFull))
+	assert.Equal("下午3:04", tw.FormatTime(v, DateShort))
+	assert.Equal("15:04", de.FormatTime(v, DateShort))
+	assert.Equal("15:04:05 GMT+08:00", de.FormatTime(v, DateFull))
+	assert.Equal("07:04:05 UTC", de.FormatTime(v, DateLong, WithTimeZone(time.UTC)))
+
+	// Hour cycles.
+	assert.Equal("15:04", en.FormatTime(v, DateShort, WithHour24()))
+	assert.Equal("3:04 PM", de.FormatTime(v, DateShort, WithHour12()))
+	assert.Equal("15:04:05", tw.FormatTime(v, DateMedium, WithHour24()))
+	assert.Equal("3:04 PM", en.FormatTime(v, DateShort, WithHour12()))
+
+	// Date and time.
+	assert.Equal("Oct 16, 2026, 3:04 PM", en.FormatDateTime(v, DateMedium, DateShort))
+	assert.Equal("October 16, 2026 at 3:04 PM", en.FormatDateTime(v, DateLong, DateShort))
+	assert.Equal("16. Oktober 2026 um 15:04", de.FormatDateTime(v, DateLong, DateShort))
+	assert.Equal("2026/10/16 下午3:04", tw.FormatDateTime(v, DateShort, DateShort))
+}
+
+func TestFormatSkeleton(t *testing.T) {
+	assert := assert.New(t)
+
+	v := time.Date(2026, time.October, 6, 9, 4, 5, 0, time.UTC)
+	en := newFormatLocale("en-us")
+	tw := newFormatLocale("zh-tw")
+	ja := newFormatLocale("ja-jp")
+	ko := newFormatLocale("ko-kr")
+
+	assert.Equal("Oct 6, 2026", en.FormatSkeleton(v, "yMMMd"))
+	assert.Equal("2026年10月6日", tw.FormatSkeleton(v, "yMMMd"))
+	assert.Equal("2026년 10월 6일", ko.FormatSkeleton(v, "yMMMd"))
+	assert.Equal("Tue, Oct 6", en.FormatSkeleton(v, "MMMEd"))
+	assert.Equal("10月6日(火)", ja.FormatSkeleton(v, "MMMEd"))
+	assert.Equal("09:04", en.FormatSkeleton(v, "Hm"))
+	assert.Equal("9:04 AM", en.FormatSkeleton(v, "hm"))
+	assert.Equal("9:04 AM", en.FormatSkeleton(v, "jm"))
+	assert.Equal("上午9:04", tw.FormatSkeleton(v, "jm"))
+	assert.Equal("9:04", ja.FormatSkeleton(v, "jm"))
+	assert.Equal("午前9:04", ja.FormatSkeleton(v, "jm", WithHour12()))
+	assert.Equal("09:04 UTC", en.FormatSkeleton(v, "Hmz"))
+
+	// Combined skeletons.
+	assert.Equal("Oct 6, 2026, 9:04 AM", en.FormatSkeleton(v, "yMMMdjm"))
+	assert.Equal("2026年10月6日 上午9:04", tw.FormatSkeleton(v, "yMMMdjm"))
+}
+
+func TestDateTemplateFuncs(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"test_date":     "Posted on {{ date .Time \"medium\" }} at {{ time .Time \"short\" }}",
+			"test_skeleton": "{{ date .Time \"yMMMd\" }}, {{ time .Time \"Hm\" }}, {{ datetime .Time \"short\" }}",
+		},
+		"zh-tw": map[string]string{
+			"test_date": "發表於 {{ date .Time \"medium\" }} {{ time .Time \"short\" }}",
+		},
+	})
+	data := map[string]any{
+		"Time": time.Date(2026, time.October, 16, 15, 4, 5, 0, time.UTC),
+	}
+	assert.Equal("Posted on Oct 16, 2026 at 3:04 PM", i.NewLocale("en-us").String("test_date", data))
+	assert.Equal("發表於 2026年10月16日 下午3:04", i.NewLocale("zh-tw").String("test_date", data))
+	assert.Equal("Oct 16, 2026, 15:04, 10/16/26, 3:04 PM", i.NewLocale("en-us").String("test_skeleton", data))
+}
@@ -0,0 +1,139 @@
+package i18n
+
+import (
+	"errors"
+	"strings"
+	"sync/atomic"
+)
+
+// defaultI18n renders the errors that are not bound to any `I18n`.
+var defaultI18n atomic.Pointer[I18n]
+
+// SetDefault registers the `I18n` that renders the errors of `NewError` and `WrapError` in its default locale,
+// so the package-level errors can be created before the translations were loaded. Passing nil unregisters it.
+func SetDefault(i *I18n) {
+	defaultI18n.Store(i)
+}
+
+// Error is an error that can be shown to the users in their languages, it wraps another error optionally.
+// The errors with the same name and context are the same for `errors.Is` regardless of the data.
+type Error struct {
+	parent  *I18n
+	message Message
+	err     error
+}
+
+// NewError creates an error of the message that is not bound to any `I18n`, `Error` renders it in the default locale
+// of the `I18n` that was registered by `SetDefault`, or returns the name of the message if there's none.
+func NewError(m Message) *Error {
+	return &Error{
+		message: m,
+	}
+}
+
+// WrapError creates an error of the message that wraps `err`, it's rendered like the errors of `NewError`.
+func WrapError(err error, m Message) *Error {
+	return &Error{
+		message: m,
+		err:     err,
+	}
+}
+
+// NewError creates an error of the message that is rendered in the default locale by `Error`.
+func (i *I18n) NewError(m Message) *Error {
+	return &Error{
+		parent:  i,
+		message: m,
+	}
+}
+
+// WrapError creates an error of the message that wraps `err` and is rendered in the default locale by `Error`.
+func (i *I18n) WrapError(err error, m Message) *Error {
+	return &Error{
+		parent:  i,
+		message: m,
+		err:     err,
+	}
+}
+
+// Message returns the message of the error.
+func (e *Error) Message() Message {
+	return e.message
+}
+
+// WithData returns a copy of the error with the data, the data are merged after the data of the message.
+func (e *Error) WithData(data ...any) *Error {
+	err := *e
+	err.message = e.message.WithData(data...)
+	return &err
+}
+
+// Wrap returns a copy of the error that wraps `err`, useful for the package-level errors.
+func (e *Error) Wrap(err error) *Error {
+	copied := *e
+	copied.err = err
+	return &copied
+}
+
+// Error renders the message in the default locale and appends the wrapped error like `fmt.Errorf("%s: %w")` does.
+func (e *Error) Error() string {
+	parent := e.parent
+	if parent == nil {
+		parent = defaultI18n.Load()
+	}
+	var text string
+	if parent != nil {
+		text = e.message.Translate(parent.NewLocale(parent.defaultLocale))
+	} else {
+		text = e.message.String()
+	}
+	if e.err != nil {
+		return text + ": " + e.err.Error()
+	}
+	return text
+}
+
+// Localize renders the message of the error in the locale without the wrapped error, so the internal details won't be shown to the users.
+func (e *Error) Localize(l *Locale) string {
+	return e.message.Translate(l)
+}
+
+// Unwrap returns the wrapped error.
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// Is reports whether the target is an `*Error` with the same name and context.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+	return t.message.name == e.message.name && t.message.context == e.message.context
+}
+
+// LocalizeError renders the whole error chain in the locale, the messages of the `*Error` in the chain are localized
+// and the other errors are output as-is. The localized messages replace the default ones in the errors that wrap them
+// (e.g. `fmt.Errorf("signing in: %w", err)`).
+func LocalizeError(err error, l *Locale) string {
+	if err == nil {
+		return ""
+	}
+	if e, ok := err.(*Error); ok {
+		text := e.Localize(l)
+		if e.err != nil {
+			return text + ": " + LocalizeError(e.err, l)
+		}
+		return text
+	}
+	text := err.Error()
+	wrapped := errors.Unwrap(err)
+	if wrapped == nil {
+		return text
+	}
+	var e *Error
+	if !errors.As(wrapped, &e) {
+		return text
+	}
+	return strings.Replace(text, wrapped.Error(), LocalizeError(wrapped, l), 1)
+}
@@ -0,0 +1,86 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testErrNotFound = NewError(NewMessage("error.not_found"))
+	testErrTooLong  = NewError(NewMessage("error.too_long"))
+)
+
+func TestError(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"error.not_found": "The user was not found.",
+			"error.too_long":  "The {{ .Field }} should be at most {{ .Max }} characters.",
+			"error.sign_in":   "Failed to sign in",
+		},
+		"zh-tw": map[string]string{
+			"error.not_found": "找不到使用者。",
+			"error.too_long":  "{{ .Field }}最多只能有 {{ .Max }} 個字。",
+			"error.sign_in":   "登入失敗",
+		},
+	})
+	tw := i.NewLocale("zh-tw")
+
+	// Unbound.
+	assert.EqualError(testErrNotFound, "error.not_found")
+	assert.Equal("找不到使用者。", testErrNotFound.Localize(tw))
+
+	// Bound to the default locale.
+	err := i.NewError(NewMessage("error.too_long", map[string]any{"Field": "name", "Max": 20}))
+	assert.EqualError(err, "The name should be at most 20 characters.")
+	assert.Equal("name最多只能有 20 個字。", err.Localize(tw))
+	assert.True(errors.Is(err, testErrTooLong))
+	assert.False(errors.Is(err, testErrNotFound))
+	assert.Equal("error.too_long", err.Message().Name())
+
+	// Data.
+	err = i.NewError(NewMessage("error.too_long")).WithData(map[string]any{"Field": "bio", "Max": 200})
+	assert.EqualError(err, "The bio should be at most 200 characters.")
+
+	// Wrapping.
+	cause := errors.New("sql: no rows in result set")
+	err = i.WrapError(cause, NewMessage("error.not_found"))
+	assert.EqualError(err, "The user was not found.: sql: no rows in result set")
+	assert.True(errors.Is(err, cause))
+	assert.True(errors.Is(err, testErrNotFound))
+	assert.Equal("找不到使用者。", err.Localize(tw))
+	assert.Equal("找不到使用者。: sql: no rows in result set", LocalizeError(err, tw))
+
+	wrapped := fmt.Errorf("handler: %w", err)
+	var e *Error
+	assert.True(errors.As(wrapped, &e))
+	assert.Equal("error.not_found", e.Message().Name())
+	assert.True(errors.Is(wrapped, testErrNotFound))
+	assert.Equal("handler: 找不到使用者。: sql: no rows in result set", LocalizeError(wrapped, tw))
+
+	// Chain.
+	chain := i.WrapError(fmt.Errorf("api: %w", testErrNotFound.Wrap(cause)), NewMessage("error.sign_in"))
+	assert.EqualError(chain, "Failed to sign in: api: error.not_found: sql: no rows in result set")
+	assert.Equal("登入失敗: api: 找不到使用者。: sql: no rows in result set", LocalizeError(chain, tw))
+	assert.Equal("登入失敗", chain.Localize(tw))
+	assert.True(errors.Is(chain, testErrNotFound))
+	assert.Nil(testErrNotFound.Unwrap())
+
+	assert.Equal("sql: no rows in result set", LocalizeError(cause, tw))
+	assert.Equal("", LocalizeError(nil, tw))
+
+	// Default.
+	SetDefault(i)
+	defer SetDefault(nil)
+	assert.EqualError(testErrNotFound, "The user was not found.")
+	assert.EqualError(testErrNotFound.Wrap(cause), "The user was not found.: sql: no rows in result set")
+	assert.EqualError(WrapError(cause, NewMessage("error.sign_in")), "Failed to sign in: sql: no rows in result set")
+	assert.EqualError(New("zh-tw").NewError(NewMessage("error.sign_in")), "error.sign_in")
+	SetDefault(nil)
+	assert.EqualError(testErrNotFound, "error.not_found")
+}
@@ -0,0 +1,82 @@
+package i18n
+
+import "strings"
+
+// escapedChars are the characters that can be escaped with a backslash in the translations,
+// so `\|`, `\<`, `\@` and `\\` are output as `|`, `<`, `@` and `\` literally.
+const escapedChars = `|<@\`
+
+// splitForms splits the translation into the plural forms by ` | `,
+// the pipes in the template actions (e.g. `{{ .Name | upper }}`) and the escaped pipes (`\|`) are not separators.
+func splitForms(text string) []string {
+	var (
+		forms  []string
+		start  int
+		action bool
+	)
+	for j := 0; j < len(text); j++ {
+		switch {
+		case action:
+			if strings.HasPrefix(text[j:], "}}") {
+				action = false
+				j++
+			}
+		case strings.HasPrefix(text[j:], "{{"):
+			action = true
+			j++
+		case text[j] == '\\':
+			j++
+		case strings.HasPrefix(text[j:], " | "):
+			forms = append(forms, text[start:j])
+			start = j + 3
+			j += 2
+		}
+	}
+	return append(forms, text[start:])
+}
+
+// unescapeText removes the backslashes of the escaped characters outside the template actions.
+func unescapeText(text string) string {
+	if !strings.Contains(text, `\`) {
+		return text
+	}
+	var b strings.Builder
+	var action bool
+	for j := 0; j < len(text); j++ {
+		switch {
+		case action:
+			if strings.HasPrefix(text[j:], "}}") {
+				action = false
+			}
+		case strings.HasPrefix(text[j:], "{{"):
+			action = true
+		case text[j] == '\\' && j+1 < len(text) && strings.IndexByte(escapedChars, text[j+1]) != -1:
+			j++
+		}
+		b.WriteByte(text[j])
+	}
+	return b.String()
+}
+
+// escapeText escapes the special characters outside the template actions, it reverses `unescapeText`.
+func escapeText(text string) string {
+	if !strings.ContainsAny(text, escapedChars) {
+		return text
+	}
+	var b strings.Builder
+	var action bool
+	for j := 0; j < len(text); j++ {
+		switch {
+		case action:
+			if strings.HasPrefix(text[j:], "}}") {
+				action = false
+			}
+		case strings.HasPrefix(text[j:], "{{"):
+			action = true
+		case strings.IndexByte(escapedChars, text[j]) != -1:
+			b.WriteByte('\\')
+		}
+		b.WriteByte(text[j])
+	}
+	return b.String()
+}
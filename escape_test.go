@@ -0,0 +1,68 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscape(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	err := i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"test_io":         `Input \| Output`,
+			"test_br":         `Use \<br> for a new line`,
+			"test_link":       `Follow \@:brand on the social media`,
+			"test_backslash":  `C:\\Users | C:\\Users\\{{ .Name }}`,
+			"test_pipe":       "{{ .Name | upper }} | {{ .Name | lower }} and others",
+			`Input \| Output`: "輸入 | 輸出",
+		},
+		"zh-tw": map[string]string{
+			`Input \| Output`:  `輸入 \| 輸出`,
+			`Use \<br> <html>`: `使用 \<br> 換行`,
+		},
+	})
+	assert.NoError(err)
+
+	l := i.NewLocale("en-us")
+	data := map[string]any{
+		"Name": "Yami",
+	}
+	assert.Equal("Input | Output", l.String("test_io"))
+	assert.Equal("Use <br> for a new line", l.String("test_br"))
+	assert.Equal("Follow @:brand on the social media", l.String("test_link"))
+	assert.Equal(`C:\Users`, l.Number("test_backslash", 1))
+	assert.Equal(`C:\Users\Yami`, l.Number("test_backslash", 2, data))
+
+	// The pipes in the template actions are not the plural separators.
+	assert.Equal("YAMI", l.Number("test_pipe", 1, data))
+	assert.Equal("yami and others", l.Number("test_pipe", 2, data))
+
+	// Keys.
+	assert.Equal("輸入", l.String(`Input \| Output`))
+	assert.Equal("輸入 | 輸出", i.NewLocale("zh-tw").String(`Input \| Output`))
+	assert.Equal("使用 <br> 換行", i.NewLocale("zh-tw").StringX(`Use \<br>`, "html"))
+
+	// Runtime translations.
+	assert.Equal("Output | Input", l.String(`Output \| Input`))
+	assert.Equal("Use <br>", l.String(`Use \<br>`))
+	assert.Equal("Use", l.String("Use <br>"))
+	assert.Equal("Post", l.StringX("Post", "adjective"))
+	assert.Equal("Tom <Jerry>", l.String(`Tom \<Jerry>`))
+}
+
+func TestEscapeText(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]string{"a", "b", "c"}, splitForms("a | b | c"))
+	assert.Equal([]string{`a \| b`, "c"}, splitForms(`a \| b | c`))
+	assert.Equal([]string{"{{ .A | b }}", "c"}, splitForms("{{ .A | b }} | c"))
+	assert.Equal([]string{""}, splitForms(""))
+
+	for _, v := range []string{"a | b", "@:x", "<a>", `C:\`, "{{ .A | upper }} | @"} {
+		assert.Equal(v, unescapeText(escapeText(v)), v)
+	}
+	assert.Equal(`\@:x \| {{ "\\" | print }}`, escapeText(`@:x | {{ "\\" | print }}`))
+}
@@ -0,0 +1,211 @@
+package i18n
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"text/template"
+	"time"
+	"unicode"
+)
+
+// templateFuncs returns the built-in functions and the custom functions that were bound to the locale,
+// they're built once per locale and shared by all the templates.
+func (i *I18n) templateFuncs(locale string) template.FuncMap {
+	if v, ok := i.funcMaps.Load(locale); ok {
+		return v.(template.FuncMap)
+	}
+	l := &Locale{
+		parent: i,
+		locale: locale,
+	}
+	funcs := localeFuncs(l)
+	for k, v := range i.funcs {
+		funcs[k] = v
+	}
+	if i.localeFuncs != nil {
+		for k, v := range i.localeFuncs(l) {
+			funcs[k] = v
+		}
+	}
+	v, _ := i.funcMaps.LoadOrStore(locale, funcs)
+	return v.(template.FuncMap)
+}
+
+// localeFuncs returns the built-in template functions that were bound to the locale.
+func localeFuncs(l *Locale) template.FuncMap {
+	return template.FuncMap{
+		// {{ upper .Name }}
+		"upper": func(v string) string {
+			return strings.ToUpperSpecial(specialCase(l.locale), v)
+		},
+		// {{ lower .Name }}
+		"lower": func(v string) string {
+			return strings.ToLowerSpecial(specialCase(l.locale), v)
+		},
+		// {{ title .Name }}
+		"title": func(v string) string {
+			return titleCase(specialCase(l.locale), v)
+		},
+		// {{ trim .Name }}
+		"trim": strings.TrimSpace,
+		// {{ replace .Name "-" " " }}
+		"replace": func(v, old, new string) string {
+			return strings.ReplaceAll(v, old, new)
+		},
+		// {{ join .Tags ", " }}
+		"join": func(items any, separator string) string {
+			return strings.Join(listItems(items), separator)
+		},
+		// {{ default "Guest" .Name }}
+		"default": func(value, v any) any {
+			if v == nil || reflect.ValueOf(v).IsZero() {
+				return value
+			}
+			return v
+		},
+		// {{ t "brand.name" }}
+		"t": func(name string, data ...any) string {
+			return l.String(name, data...)
+		},
+		// {{ locale }}
+		"locale": func() string {
+			return l.locale
+		},
+		// {{ number .Count }}
+		"number": func(v any) string {
+			return l.FormatNumber(v)
+		},
+		// {{ decimal .Price 2 }}
+		"decimal": func(v any, digits int) string {
+			return l.FormatNumber(v, WithFractionDigits(digits, digits))
+		},
+		// {{ percent .Ratio }}
+		"percent": func(v any) string {
+			return l.FormatNumber(v, WithPercent())
+		},
+		// {{ scientific .Distance }}
+		"scientific": func(v any) string {
+			return l.FormatNumber(v, WithScientific())
+		},
+		// {{ compact .Followers }}
+		"compact": func(v any) string {
+			text, _ := l.FormatCompact(v)
+			return text
+		},
+		// {{ compactLong .Followers }}
+		"compactLong": func(v any) string {
+			text, _ := l.FormatCompact(v, WithCompactLong())
+			return text
+		},
+		// {{ currency .Price "TWD" }}
+		"currency": func(v any, currency string) string {
+			return l.FormatCurrency(v, currency)
+		},
+		// {{ date .CreatedAt "medium" }} or {{ date .CreatedAt "yMMMd" }}
+		"date": func(t time.Time, style string) string {
+			if v, ok := dateStyles[style]; ok {
+				return l.FormatDate(t, v)
+			}
+			return l.FormatSkeleton(t, style)
+		},
+		// {{ time .CreatedAt "short" }} or {{ time .CreatedAt "Hm" }}
+		"time": func(t time.Time, style string) string {
+			if v, ok := dateStyles[style]; ok {
+				return l.FormatTime(t, v)
+			}
+			return l.FormatSkeleton(t, style)
+		},
+		// {{ datetime .CreatedAt "short" }}
+		"datetime": func(t time.Time, style string) string {
+			v := dateStyles[style]
+			return l.FormatDateTime(t, v, v)
+		},
+		// {{ relativeTime .CreatedAt }}
+		"relativeTime": func(t time.Time) string {
+			return l.FormatRelative(t, time.Now(), WithNumericAuto())
+		},
+		// {{ duration .Elapsed }} or {{ duration .Elapsed "narrow" }}
+		"duration": func(d time.Duration, width ...string) string {
+			if len(width) > 0 {
+				return l.FormatDuration(d, WithWidth(widths[width[0]]))
+			}
+			return l.FormatDuration(d)
+		},
+		// {{ list .Names }} or {{ list .Names "disjunction" "short" }}
+		"list": func(items any, options ...string) string {
+			var style ListStyle
+			var width Width
+			if len(options) > 0 {
+				style = listStyles[options[0]]
+			}
+			if len(options) > 1 {
+				width = widths[options[1]]
+			}
+			return l.FormatList(listItems(items), style, width)
+		},
+		// {{ unit .Distance "kilometer" }} or {{ unit .Distance "kilometer" "short" }}
+		"unit": func(v any, unit string, width ...string) string {
+			if len(width) > 0 {
+				return l.FormatUnit(v, unit, WithWidth(widths[width[0]]))
+			}
+			return l.FormatUnit(v, unit)
+		},
+		// {{ bytes .Size }}
+		"bytes": func(v any) string {
+			return l.FormatByteSize(toInt64(v))
+		},
+	}
+}
+
+// listItems converts a slice or an array to the strings.
+func listItems(v any) []string {
+	if items, ok := v.([]string); ok {
+		return items
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return []string{fmt.Sprint(v)}
+	}
+	items := make([]string, rv.Len())
+	for j := range items {
+		items[j] = fmt.Sprint(rv.Index(j).Interface())
+	}
+	return items
+}
+
+// toInt64 converts the integers and the floats to an int64.
+func toInt64(v any) int64 {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return int64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return int64(rv.Float())
+	}
+	return 0
+}
+
+// specialCase returns the special case mappings of the locale, such as the dotted `İ` of Turkish.
+func specialCase(locale string) unicode.SpecialCase {
+	switch language, _, _ := strings.Cut(locale, "-"); language {
+	case "tr", "az":
+		return unicode.TurkishCase
+	}
+	return nil
+}
+
+// titleCase converts the first letter of each word to the title case.
+func titleCase(c unicode.SpecialCase, v string) string {
+	previous := ' '
+	return strings.Map(func(r rune) rune {
+		first := unicode.IsSpace(previous) || previous == '-'
+		previous = r
+		if first {
+			return c.ToTitle(r)
+		}
+		return r
+	}, v)
+}
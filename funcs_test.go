@@ -0,0 +1,100 @@
+package i18n
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuiltinFuncs(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"test_case":    "{{ upper .Name }}, {{ lower .Name }}, {{ title .Title }}",
+			"test_strings": "{{ trim .Padded }}|{{ replace .Slug \"-\" \" \" }}|{{ join .Tags \", \" }}",
+			"test_default": "Hello, {{ default \"Guest\" .Name }}!",
+			"test_locale":  "{{ locale }}",
+		},
+		"tr-tr": map[string]string{
+			"test_case": "{{ upper .Name }}, {{ lower .Name }}, {{ title .Title }}",
+		},
+	})
+	data := map[string]any{
+		"Name":   "Istanbul iki",
+		"Title":  "hello wide-world",
+		"Padded": "  Yami  ",
+		"Slug":   "hello-world",
+		"Tags":   []string{"go", "i18n"},
+	}
+	assert.Equal("ISTANBUL IKI, istanbul iki, Hello Wide-World", i.NewLocale("en-us").String("test_case", data))
+	assert.Equal("ISTANBUL İKİ, ıstanbul iki, Hello Wide-World", i.NewLocale("tr-tr").String("test_case", data))
+	assert.Equal("Yami|hello world|go, i18n", i.NewLocale("en-us").String("test_strings", data))
+	assert.Equal("Hello, Guest!", i.NewLocale("en-us").String("test_default", map[string]any{}))
+	assert.Equal("Hello, Yami!", i.NewLocale("en-us").String("test_default", map[string]any{"Name": "Yami"}))
+	assert.Equal("en-us", i.NewLocale("en-us").String("test_locale"))
+	assert.Equal("tr-tr", i.NewLocale("tr-tr").String("test_locale"))
+}
+
+func TestWithFuncs(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us", WithFuncs(template.FuncMap{
+		"shout": func(v string) string {
+			return strings.ToUpper(v) + "!"
+		},
+		// Overrides the built-in function.
+		"trim": func(v string) string {
+			return strings.Trim(v, "*")
+		},
+	}))
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"test_shout": "{{ shout .Name }}",
+			"test_trim":  "{{ trim .Name }}",
+		},
+		"zh-tw": map[string]string{},
+	})
+	assert.Equal("YAMI!", i.NewLocale("en-us").String("test_shout", map[string]any{"Name": "yami"}))
+	assert.Equal("Yami", i.NewLocale("en-us").String("test_trim", map[string]any{"Name": "**Yami**"}))
+
+	// Fallback and runtime translations.
+	assert.Equal("YAMI!", i.NewLocale("zh-tw").String("test_shout", map[string]any{"Name": "yami"}))
+	assert.Equal("Hi, YAMI!", i.NewLocale("en-us").String("Hi, {{ shout .Name }}", map[string]any{"Name": "yami"}))
+}
+
+func TestWithLocaleFuncs(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us", WithLocaleFuncs(func(l *Locale) template.FuncMap {
+		return template.FuncMap{
+			"greet": func(name string) string {
+				if l.Locale() == "zh-tw" {
+					return name + "，你好"
+				}
+				return "Hello, " + name
+			},
+			"t": func(name string) string {
+				return l.String(name)
+			},
+		}
+	}))
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"test_greet": "{{ greet .Name }}!",
+			"test_brand": "Yami",
+			"test_about": "About {{ t \"test_brand\" }}",
+		},
+		"zh-tw": map[string]string{
+			"test_brand": "雅美",
+		},
+	})
+	data := map[string]any{"Name": "Yami"}
+	assert.Equal("Hello, Yami!", i.NewLocale("en-us").String("test_greet", data))
+	assert.Equal("Yami，你好!", i.NewLocale("zh-tw").String("test_greet", data))
+	assert.Equal("About Yami", i.NewLocale("en-us").String("test_about"))
+	assert.Equal("About 雅美", i.NewLocale("zh-tw").String("test_about"))
+}
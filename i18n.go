@@ -1,12 +1,14 @@
 package i18n
 
 import (
-	"encoding/json"
+	"context"
 	"io/fs"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"text/template"
 )
 
@@ -21,13 +23,23 @@ type I18n struct {
 	defaultLocale               string
 	pluralizors                 map[string]Pluralizor
 	unmarshaler                 Unmarshaler
+	unmarshalers                map[string]Unmarshaler
 	fallbacks                   map[string][]string
 	translations                map[string]map[string]string
 	runtimeCompiledTranslations map[string]*compiledTranslation
-	compiledTranslations        map[string]map[string]*compiledTranslation
+	runtimeMutex                sync.RWMutex
+	compiled                    atomic.Pointer[catalog]
+	loadMutex                   sync.Mutex
+	pseudoPluralizors           sync.Map
+	funcs                       template.FuncMap
+	localeFuncs                 func(*Locale) template.FuncMap
+	funcMaps                    sync.Map
+	placeholder                 Placeholder
+	fileNamespace               bool
+	pathPatterns                []*pathPattern
 }
 
-// WithUnmarshaler replaces the default translation file unmarshaler.
+// WithUnmarshaler replaces the default translation file unmarshalers, it's used for all the files regardless of the extensions.
 func WithUnmarshaler(u Unmarshaler) func(*I18n) {
 	return func(i *I18n) {
 		i.unmarshaler = u
@@ -48,61 +60,129 @@ func WithPluralizor(p map[string]Pluralizor) func(*I18n) {
 	}
 }
 
+// WithFuncs adds the template functions to all the translations, the functions override the built-in functions with the same names.
+func WithFuncs(f template.FuncMap) func(*I18n) {
+	return func(i *I18n) {
+		i.funcs = f
+	}
+}
+
+// WithLocaleFuncs adds the template functions that were bound to the locale of the translations,
+// the function will be called for each locale when the translations are rendered for the first time.
+func WithLocaleFuncs(f func(*Locale) template.FuncMap) func(*I18n) {
+	return func(i *I18n) {
+		i.localeFuncs = f
+	}
+}
+
+// WithFileNamespace prepends the namespace of the filenames to the names when loading the files,
+// so the translations of `zh_tw.hello.json` are named like `hello.message` instead of `message`.
+func WithFileNamespace() func(*I18n) {
+	return func(i *I18n) {
+		i.fileNamespace = true
+	}
+}
+
 // New creates a new internationalization.
 func New(defaultLocale string, options ...func(*I18n)) *I18n {
 	i := &I18n{
 		defaultLocale:               nameInsenstive(defaultLocale),
-		unmarshaler:                 json.Unmarshal,
+		unmarshalers:                defaultUnmarshalers(),
 		pluralizors:                 make(map[string]Pluralizor),
 		fallbacks:                   make(map[string][]string),
 		translations:                make(map[string]map[string]string),
 		runtimeCompiledTranslations: make(map[string]*compiledTranslation),
-		compiledTranslations:        make(map[string]map[string]*compiledTranslation),
 	}
+	i.compiled.Store(newCatalog())
 	for _, o := range options {
 		o(i)
 	}
 	return i
 }
 
-// LoadMap loads the translations from the map.
+// catalog is the compiled translations, the loaders change a copy of it and replace it as a whole
+// so the locales never see a half-loaded one.
+type catalog struct {
+	translations map[string]map[string]*compiledTranslation
+	contexts     map[string]map[contextKey]*compiledTranslation
+	metadata     map[string]map[string]map[string]string
+}
+
+// newCatalog
+func newCatalog() *catalog {
+	return &catalog{
+		translations: make(map[string]map[string]*compiledTranslation),
+		contexts:     make(map[string]map[contextKey]*compiledTranslation),
+		metadata:     make(map[string]map[string]map[string]string),
+	}
+}
+
+// clone copies the catalog so it can be changed without affecting the locales that are reading the current one.
+func (c *catalog) clone() *catalog {
+	v := &catalog{
+		translations: make(map[string]map[string]*compiledTranslation, len(c.translations)),
+		contexts:     make(map[string]map[contextKey]*compiledTranslation, len(c.contexts)),
+		metadata:     make(map[string]map[string]map[string]string, len(c.metadata)),
+	}
+	for locale, translations := range c.translations {
+		v.translations[locale] = make(map[string]*compiledTranslation, len(translations))
+		for name, trans := range translations {
+			v.translations[locale][name] = trans
+		}
+	}
+	for locale, contexts := range c.contexts {
+		v.contexts[locale] = contexts
+	}
+	for locale, metadata := range c.metadata {
+		v.metadata[locale] = metadata
+	}
+	return v
+}
+
+// update changes a copy of the current catalog and swaps it in when it's done, the current catalog is kept if an error occurred.
+func (i *I18n) update(f func(c *catalog) error) error {
+	i.loadMutex.Lock()
+	defer i.loadMutex.Unlock()
+
+	c := i.compiled.Load().clone()
+	if err := f(c); err != nil {
+		return err
+	}
+	i.compiled.Store(c)
+	return nil
+}
+
+// LoadMap loads the translations from the map, the current translations are kept if an error occurred.
 func (i *I18n) LoadMap(languages map[string]map[string]string) error {
+	return i.update(func(c *catalog) error {
+		return i.loadMap(c, languages)
+	})
+}
+
+// loadMap compiles the translations of the map into the catalog.
+func (i *I18n) loadMap(c *catalog, languages map[string]map[string]string) error {
 	for locale, translations := range languages {
 		locale = nameInsenstive(locale)
-		i.compiledTranslations[locale] = make(map[string]*compiledTranslation)
+		c.translations[locale] = make(map[string]*compiledTranslation)
 
 		for name, text := range translations {
 			trans := i.compileTranslation(locale, name, text)
-			i.compiledTranslations[locale][name] = trans
+			c.translations[locale][name] = trans
 		}
 	}
-	i.compileFallbacks()
+	i.compileFallbacks(c)
+	if err := i.compileLinks(c); err != nil {
+		return err
+	}
+	i.compileContexts(c)
 	return nil
 }
 
-// LoadFiles loads the translations from the files.
+// LoadFiles loads the translations from the files, the `path` metadata is the path of the file like `NewFSSource`.
 func (i *I18n) LoadFiles(filenames ...string) error {
-	data := make(map[string]map[string]string)
-
-	for _, v := range filenames {
-		b, err := os.ReadFile(v)
-		if err != nil {
-			return err
-		}
-		var trans map[string]string
-		if err := i.unmarshaler(b, &trans); err != nil {
-			return err
-		}
-		locale := nameInsenstive(v)
-		_, ok := data[locale]
-		if !ok {
-			data[locale] = make(map[string]string)
-		}
-		for name, text := range trans {
-			data[locale][name] = text
-		}
-	}
-	return i.LoadMap(data)
+	return i.LoadSource(context.Background(), SourceFunc(func(ctx context.Context) ([]Entry, error) {
+		return i.loadFiles(ctx, os.ReadFile, filenames)
+	}))
 }
 
 // LoadGlob loads the translations from the files that matches specified patterns.
@@ -122,38 +202,7 @@ func (i *I18n) LoadGlob(pattern ...string) error {
 
 // LoadFS loads the translation from a `fs.FS`, useful for `go:embed`.
 func (i *I18n) LoadFS(fsys fs.FS, patterns ...string) error {
-	var files []string
-	data := make(map[string]map[string]string)
-
-	for _, pattern := range patterns {
-		v, err := fs.Glob(fsys, pattern)
-		if err != nil {
-			return err
-		}
-		files = append(files, v...)
-	}
-
-	for _, v := range files {
-		b, err := fs.ReadFile(fsys, v)
-		if err != nil {
-			return err
-		}
-		var trans map[string]string
-		if err := i.unmarshaler(b, &trans); err != nil {
-			return err
-		}
-
-		locale := nameInsenstive(v)
-
-		_, ok := data[locale]
-		if !ok {
-			data[locale] = make(map[string]string)
-		}
-		for name, text := range trans {
-			data[locale][name] = text
-		}
-	}
-	return i.LoadMap(data)
+	return i.LoadSource(context.Background(), i.NewFSSource(fsys, patterns...))
 }
 
 // NewLocale reads a locale from the internationalization core.
@@ -161,7 +210,7 @@ func (i *I18n) NewLocale(locales ...string) *Locale {
 	selectedLocale := i.defaultLocale
 	for _, v := range locales {
 		v = nameInsenstive(v)
-		if _, ok := i.compiledTranslations[v]; ok {
+		if _, ok := i.compiled.Load().translations[v]; ok {
 			selectedLocale = v
 			break
 		}
@@ -172,20 +221,95 @@ func (i *I18n) NewLocale(locales ...string) *Locale {
 	}
 }
 
-var contextRegExp = regexp.MustCompile("<(.*?)>$")
+var contextRegExp = regexp.MustCompile(" <([^<>]*)>$")
+
+// contextKey is the name and the context of a translation that was named like `name <context>`.
+type contextKey struct {
+	name    string
+	context string
+}
+
+// compileContexts indexes the translations with the contexts by the names and the contexts,
+// so `StringX` and `NumberX` don't have to build the keys.
+func (i *I18n) compileContexts(c *catalog) {
+	for locale, translations := range c.translations {
+		contexts := make(map[contextKey]*compiledTranslation)
+		for name, trans := range translations {
+			if loc := contextRegExp.FindStringSubmatchIndex(name); loc != nil {
+				contexts[contextKey{name[:loc[0]], name[loc[2]:loc[3]]}] = trans
+			}
+		}
+		c.contexts[locale] = contexts
+	}
+}
 
 // compiledTranslation
 type compiledTranslation struct {
 	locale     string
 	name       string
+	raw        string
+	links      string
 	pluralizor Pluralizor
 	texts      []*compiledText
 }
 
+// source returns the original translation, or joins the escaped texts back if it was loaded from a `CompiledCatalog`.
+func (c *compiledTranslation) source() string {
+	if c.raw != "" {
+		return c.raw
+	}
+	texts := make([]string, len(c.texts))
+	for j, v := range c.texts {
+		texts[j] = escapeText(v.text)
+	}
+	return strings.Join(texts, " | ")
+}
+
+// linkSource returns the translation before its links were resolved, so the links of a fallback translation
+// are resolved again within the current locale even if it was loaded earlier.
+func (c *compiledTranslation) linkSource() string {
+	if c.links != "" {
+		return c.links
+	}
+	return c.source()
+}
+
 // compiledText
 type compiledText struct {
-	text string
-	tmpl *template.Template
+	text         string
+	template     bool
+	placeholders []placeholder
+	once         sync.Once
+	tmpl         *template.Template
+	locales      sync.Map
+}
+
+// parse parses the template once when it's rendered for the first time, the raw text will be used if it's not a valid template.
+// The functions are bound to the default locale, they will be replaced when the template was localized.
+func (c *compiledText) parse(i *I18n) *template.Template {
+	c.once.Do(func() {
+		c.tmpl, _ = template.New("").Funcs(i.templateFuncs(i.defaultLocale)).Parse(c.text)
+	})
+	return c.tmpl
+}
+
+// localize returns a copy of the template with the functions bound to the locale,
+// so a fallback translation still formats the data with the conventions of the current locale.
+func (c *compiledText) localize(l *Locale) *template.Template {
+	if v, ok := c.locales.Load(l.locale); ok {
+		return v.(*template.Template)
+	}
+	tmpl := c.parse(l.parent)
+	if tmpl == nil {
+		return nil
+	}
+	clone, err := tmpl.Clone()
+	if err != nil {
+		return nil
+	}
+	clone.Funcs(l.parent.templateFuncs(l.locale))
+	v, _ := c.locales.LoadOrStore(l.locale, clone)
+	return v.(*template.Template)
 }
 
 // defaultPluralizor
@@ -210,13 +334,16 @@ func defaultPluralizor(number, choices int) int {
 	}
 }
 
-// pluralizor
+// pluralizor returns the pluralizor of the locale, the pseudo locales use the one of the default locale
+// unless they have their own.
 func (i *I18n) pluralizor(lang string) Pluralizor {
-	v, ok := i.pluralizors[lang]
-	if !ok {
-		return defaultPluralizor
+	if v, ok := i.pluralizors[lang]; ok {
+		return v
 	}
-	return v
+	if v, ok := i.pseudoPluralizors.Load(lang); ok {
+		return v.(Pluralizor)
+	}
+	return defaultPluralizor
 }
 
 // trimContext
@@ -230,30 +357,44 @@ func (i *I18n) compileTranslation(locale, name, text string) *compiledTranslatio
 		name: name,
 	}
 	compTrans.locale = locale
+	compTrans.raw = text
 	compTrans.pluralizor = i.pluralizor(locale)
-	compTrans.texts = compileText(text)
+	compTrans.texts = i.compileText(text)
 
 	return compTrans
 }
 
 // compileText
-func compileText(text string) (compTexts []*compiledText) {
-	texts := strings.Split(text, " | ")
+func (i *I18n) compileText(text string) (compTexts []*compiledText) {
+	texts := splitForms(text)
 
 	for _, v := range texts {
-		compText := &compiledText{}
-
-		if strings.Contains(v, "{{") {
-			t, _ := template.New("").Parse(v)
-			compText.tmpl = t
-		} else {
-			compText.text = v
+		v = unescapeText(v)
+		compText := &compiledText{
+			text:     v,
+			template: strings.Contains(v, "{{"),
+		}
+		if !compText.template {
+			compText.placeholders = i.compilePlaceholders(v)
 		}
 		compTexts = append(compTexts, compText)
 	}
 	return
 }
 
+// namespace returns the prefix of the names for the file (e.g. `hello.` for `zh_tw.hello.json`) if `WithFileNamespace` was used.
+func (i *I18n) namespace(filename string) string {
+	if !i.fileNamespace {
+		return ""
+	}
+	v := filepath.Base(filename)
+	v = strings.TrimSuffix(v, filepath.Ext(v))
+	if _, namespace, ok := strings.Cut(v, "."); ok && namespace != "" {
+		return namespace + "."
+	}
+	return ""
+}
+
 // nameInsenstive converts `zh_TW.music.json`, `zh_TW` and `zh-TW` to `zh-tw`.
 func nameInsenstive(v string) string {
 	v = filepath.Base(v)
@@ -264,17 +405,17 @@ func nameInsenstive(v string) string {
 }
 
 // compileFallbacks
-func (i *I18n) compileFallbacks() {
-	for _, grandTrans := range i.compiledTranslations[i.defaultLocale] {
-		for locale, trans := range i.compiledTranslations {
+func (i *I18n) compileFallbacks(c *catalog) {
+	for _, grandTrans := range c.translations[i.defaultLocale] {
+		for locale, trans := range c.translations {
 			//
 			if locale == i.defaultLocale {
 				continue
 			}
 			//
 			if _, ok := trans[grandTrans.name]; !ok {
-				if bestfit := i.lookupBestFallback(locale, grandTrans.name); bestfit != nil {
-					i.compiledTranslations[locale][grandTrans.name] = bestfit
+				if bestfit := i.lookupBestFallback(c, locale, grandTrans.name); bestfit != nil {
+					c.translations[locale][grandTrans.name] = bestfit
 				}
 			}
 		}
@@ -282,18 +423,18 @@ func (i *I18n) compileFallbacks() {
 }
 
 // lookupBestFallback
-func (i *I18n) lookupBestFallback(locale, name string) *compiledTranslation {
+func (i *I18n) lookupBestFallback(c *catalog, locale, name string) *compiledTranslation {
 	fallbacks, ok := i.fallbacks[locale]
 	if !ok {
-		if v, ok := i.compiledTranslations[i.defaultLocale][name]; ok {
+		if v, ok := c.translations[i.defaultLocale][name]; ok {
 			return v
 		}
 	}
 	for _, fallback := range fallbacks {
-		if v, ok := i.compiledTranslations[fallback][name]; ok {
+		if v, ok := c.translations[fallback][name]; ok {
 			return v
 		}
-		if j := i.lookupBestFallback(fallback, name); j != nil {
+		if j := i.lookupBestFallback(c, fallback, name); j != nil {
 			return j
 		}
 	}
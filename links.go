@@ -0,0 +1,119 @@
+package i18n
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// linkRegExp matches `@:brand.name`, `@:(brand name)` and the `{{ t "brand.name" }}` function calls without the data,
+// the escaped characters (e.g. `\@:`) are matched as well so they can be skipped.
+var linkRegExp = regexp.MustCompile(`\\.|@:\(([^)]+)\)|@:([\w.\-]*\w)|\{\{\s*t\s+"([^"\\]*)"\s*\}\}`)
+
+// hasLinks
+func hasLinks(text string) bool {
+	for _, v := range linkRegExp.FindAllString(text, -1) {
+		if !strings.HasPrefix(v, "\\") {
+			return true
+		}
+	}
+	return false
+}
+
+// linker inlines the linked translations of a locale.
+type linker struct {
+	i        *I18n
+	catalog  *catalog
+	locale   string
+	resolved map[string]string
+	visiting []string
+}
+
+// compileLinks replaces the links with the linked translations of the same locale (or the fallbacks),
+// so the linked messages cost no more than the plain translations. An error is returned if the links are circular.
+func (i *I18n) compileLinks(c *catalog) error {
+	locales := make([]string, 0, len(c.translations))
+	for locale := range c.translations {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+
+	for _, locale := range locales {
+		translations := c.translations[locale]
+		names := make([]string, 0, len(translations))
+		for name, trans := range translations {
+			if hasLinks(trans.linkSource()) {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		l := &linker{
+			i:        i,
+			catalog:  c,
+			locale:   locale,
+			resolved: make(map[string]string),
+		}
+		compiled := make(map[string]*compiledTranslation, len(names))
+		for _, name := range names {
+			text, err := l.resolve(name)
+			if err != nil {
+				return err
+			}
+			trans := translations[name]
+			compiled[name] = &compiledTranslation{
+				locale:     trans.locale,
+				name:       name,
+				links:      trans.linkSource(),
+				pluralizor: trans.pluralizor,
+				texts:      i.compileText(text),
+			}
+		}
+		for name, trans := range compiled {
+			translations[name] = trans
+		}
+	}
+	return nil
+}
+
+// resolve returns the translation with the links inlined, the name will be used if the linked translation doesn't exist.
+func (l *linker) resolve(name string) (string, error) {
+	if v, ok := l.resolved[name]; ok {
+		return v, nil
+	}
+	for j, v := range l.visiting {
+		if v == name {
+			return "", fmt.Errorf("circular translation links in %q: %s -> %s", l.locale, strings.Join(l.visiting[j:], " -> "), name)
+		}
+	}
+	trans, ok := l.catalog.translations[l.locale][name]
+	if !ok {
+		trans = l.i.lookupBestFallback(l.catalog, l.locale, name)
+	}
+	if trans == nil {
+		return name, nil
+	}
+
+	l.visiting = append(l.visiting, name)
+	var err error
+	text := linkRegExp.ReplaceAllStringFunc(trans.linkSource(), func(v string) string {
+		if err != nil || strings.HasPrefix(v, "\\") {
+			return v
+		}
+		m := linkRegExp.FindStringSubmatch(v)
+		linked, e := l.resolve(m[1] + m[2] + m[3])
+		if e != nil {
+			err = e
+			return v
+		}
+		// Only the first form of a plural translation will be linked.
+		return splitForms(linked)[0]
+	})
+	l.visiting = l.visiting[:len(l.visiting)-1]
+	if err != nil {
+		return "", err
+	}
+	l.resolved[name] = text
+	return text, nil
+}
@@ -0,0 +1,120 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinks(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	err := i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"brand.name":     "Acme",
+			"brand.slogan":   "@:brand.name, made for you.",
+			"welcome":        "Welcome to @:(brand.name)!",
+			"welcome_user":   "Hello, {{ .Name }}! Welcome to {{ t \"brand.name\" }}.",
+			"nested":         "@:brand.slogan Try @:brand.name today.",
+			"test_missing":   "@:test_unknown",
+			"test_apples":    "No apples | 1 apple | {{ .Count }} apples",
+			"test_basket":    "Basket: @:test_apples",
+			"test_greeting":  "{{ t \"welcome_user\" . }}",
+			"test_untouched": "mail me at yami@example.com",
+		},
+		"zh-tw": map[string]string{
+			"brand.name": "艾克米",
+			"welcome":    "歡迎來到@:brand.name！",
+		},
+	})
+	assert.NoError(err)
+
+	en := i.NewLocale("en-us")
+	assert.Equal("Acme, made for you.", en.String("brand.slogan"))
+	assert.Equal("Welcome to Acme!", en.String("welcome"))
+	assert.Equal("Hello, Yami! Welcome to Acme.", en.String("welcome_user", map[string]any{"Name": "Yami"}))
+	assert.Equal("Acme, made for you. Try Acme today.", en.String("nested"))
+	assert.Equal("test_unknown", en.String("test_missing"))
+	assert.Equal("Basket: No apples", en.String("test_basket"))
+	assert.Equal("Hello, Yami! Welcome to Acme.", en.String("test_greeting", map[string]any{"Name": "Yami"}))
+	assert.Equal("mail me at yami@example.com", en.String("test_untouched"))
+
+	// The links of a fallback translation are resolved within the current locale.
+	tw := i.NewLocale("zh-tw")
+	assert.Equal("歡迎來到艾克米！", tw.String("welcome"))
+	assert.Equal("艾克米, made for you.", tw.String("brand.slogan"))
+	assert.Equal("Hello, Yami! Welcome to 艾克米.", tw.String("welcome_user", map[string]any{"Name": "Yami"}))
+
+	// The links were inlined when loading.
+	assert.Equal("Acme, made for you.", i.compiled.Load().translations["en-us"]["brand.slogan"].source())
+	assert.Equal("艾克米, made for you.", i.compiled.Load().translations["zh-tw"]["brand.slogan"].source())
+	assert.Equal("en-us", i.compiled.Load().translations["zh-tw"]["brand.slogan"].locale)
+}
+
+func TestLinksLoadOrder(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	assert.NoError(i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"brand.name":   "Acme",
+			"brand.slogan": "@:brand.name, made for you.",
+			"nested":       "@:brand.slogan Try @:brand.name today.",
+		},
+	}))
+	assert.NoError(i.LoadMap(map[string]map[string]string{
+		"zh-tw": map[string]string{
+			"brand.name": "艾克米",
+		},
+	}))
+
+	en, tw := i.NewLocale("en-us"), i.NewLocale("zh-tw")
+	assert.Equal("Acme, made for you.", en.String("brand.slogan"))
+	assert.Equal("艾克米, made for you.", tw.String("brand.slogan"))
+	assert.Equal("艾克米, made for you. Try 艾克米 today.", tw.String("nested"))
+	assert.Equal("Acme, made for you. Try Acme today.", en.String("nested"))
+}
+
+func TestLinksCycle(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	err := i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"a": "@:b",
+			"b": "{{ t \"c\" }}",
+			"c": "@:(a)",
+		},
+	})
+	assert.EqualError(err, "circular translation links in \"en-us\": a -> b -> c -> a")
+
+	err = New("en-us").LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"a": "@:a",
+		},
+	})
+	assert.EqualError(err, "circular translation links in \"en-us\": a -> a")
+
+	// The current translations are kept.
+	i = New("en-us")
+	assert.NoError(i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"a":           "A",
+			"b":           "B",
+			"Post <verb>": "Post it",
+		},
+	}))
+	err = i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"a":           "@:b",
+			"b":           "@:a",
+			"Post <verb>": "Post",
+		},
+	})
+	assert.EqualError(err, "circular translation links in \"en-us\": a -> b -> a")
+	l := i.NewLocale("en-us")
+	assert.Equal("A", l.String("a"))
+	assert.Equal("Post it", l.StringX("Post", "verb"))
+	assert.Equal("Post it", l.String("Post <verb>"))
+}
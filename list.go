@@ -0,0 +1,188 @@
+package i18n
+
+import (
+	"strings"
+)
+
+// ListStyle is the kind of the list.
+type ListStyle int
+
+const (
+	// ListConjunction joins the items with "and" (e.g. `A, B, and C`).
+	ListConjunction ListStyle = iota
+	// ListDisjunction joins the items with "or" (e.g. `A, B, or C`).
+	ListDisjunction
+	// ListUnit joins the measurements (e.g. `3 feet, 7 inches`).
+	ListUnit
+)
+
+// listStyles maps the names that were used in the templates to the list styles.
+var listStyles = map[string]ListStyle{
+	"conjunction": ListConjunction,
+	"disjunction": ListDisjunction,
+	"unit":        ListUnit,
+}
+
+// FormatList joins the items with the list patterns of the locale (e.g. `Alice, Bob, and Carol`, `Alice、Bob和Carol`).
+func (l *Locale) FormatList(items []string, style ListStyle, width Width) string {
+	p := lookupLocale(listDatas, l.locale).patterns(style, width)
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	case 2:
+		return listPattern(p.two, items[0], items[1])
+	}
+	text := listPattern(p.end, items[len(items)-2], items[len(items)-1])
+	for j := len(items) - 3; j > 0; j-- {
+		text = listPattern(p.middle, items[j], text)
+	}
+	return listPattern(p.start, items[0], text)
+}
+
+// listPattern
+func listPattern(pattern, first, second string) string {
+	return strings.NewReplacer("{0}", first, "{1}", second).Replace(pattern)
+}
+
+// listPatterns is the CLDR list patterns, a list with 3 or more items starts with `start`,
+// joins the inner items with `middle`, and ends with `end`. A list with 2 items uses `two`.
+type listPatterns struct {
+	start  string
+	middle string
+	end    string
+	two    string
+}
+
+// listData is the list patterns of a locale by the styles and the widths.
+type listData [3][3]listPatterns
+
+// patterns returns the patterns of the style, the narrower widths fall back to the wider ones if they are missing.
+func (d *listData) patterns(style ListStyle, width Width) listPatterns {
+	for w := width; w > WidthLong; w-- {
+		if d[style][w].two != "" {
+			return d[style][w]
+		}
+	}
+	return d[style][WidthLong]
+}
+
+// commaList creates the list patterns that join the items with a comma, and the last one with `end`.
+func commaList(comma, end string) listPatterns {
+	return listPatterns{
+		start:  "{0}" + comma + "{1}",
+		middle: "{0}" + comma + "{1}",
+		end:    "{0}" + end + "{1}",
+		two:    "{0}" + end + "{1}",
+	}
+}
+
+var listDatas = map[string]*listData{
+	"en": {
+		ListConjunction: {
+			{start: "{0}, {1}", middle: "{0}, {1}", end: "{0}, and {1}", two: "{0} and {1}"},
+			{start: "{0}, {1}", middle: "{0}, {1}", end: "{0}, & {1}", two: "{0} & {1}"},
+			commaList(", ", ", "),
+		},
+		ListDisjunction: {
+			{start: "{0}, {1}", middle: "{0}, {1}", end: "{0}, or {1}", two: "{0} or {1}"},
+		},
+		ListUnit: {
+			commaList(", ", ", "),
+			{},
+			commaList(" ", " "),
+		},
+	},
+	"zh": {
+		ListConjunction: {
+			commaList("、", "和"),
+		},
+		ListDisjunction: {
+			commaList("、", "或"),
+		},
+		ListUnit: {
+			commaList("", ""),
+		},
+	},
+	"zh-tw": {
+		ListConjunction: {
+			commaList("、", "和"),
+		},
+		ListDisjunction: {
+			commaList("、", "或"),
+		},
+		ListUnit: {
+			commaList(" ", " "),
+			{},
+			commaList("", ""),
+		},
+	},
+	"ja": {
+		ListConjunction: {
+			commaList("、", "、"),
+		},
+		ListDisjunction: {
+			commaList("、", "、または"),
+		},
+		ListUnit: {
+			commaList(" ", " "),
+			{},
+			commaList("", ""),
+		},
+	},
+	"ko": {
+		ListConjunction: {
+			commaList(", ", " 및 "),
+		},
+		ListDisjunction: {
+			commaList(", ", " 또는 "),
+		},
+		ListUnit: {
+			commaList(" ", " "),
+		},
+	},
+	"de": {
+		ListConjunction: {
+			commaList(", ", " und "),
+		},
+		ListDisjunction: {
+			commaList(", ", " oder "),
+		},
+		ListUnit: {
+			commaList(", ", " und "),
+			{},
+			commaList(" ", " "),
+		},
+	},
+	"fr": {
+		ListConjunction: {
+			commaList(", ", " et "),
+		},
+		ListDisjunction: {
+			commaList(", ", " ou "),
+		},
+		ListUnit: {
+			commaList(", ", " et "),
+			{},
+			commaList(" ", " "),
+		},
+	},
+	"es": {
+		ListConjunction: {
+			commaList(", ", " y "),
+		},
+		ListDisjunction: {
+			commaList(", ", " o "),
+		},
+		ListUnit: {
+			commaList(", ", " y "),
+			{},
+			commaList(" ", " "),
+		},
+	},
+}
+
+func init() {
+	listDatas["zh-hk"] = listDatas["zh-tw"]
+}
@@ -0,0 +1,63 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatList(t *testing.T) {
+	assert := assert.New(t)
+
+	en := newFormatLocale("en-us")
+	tw := newFormatLocale("zh-tw")
+	fr := newFormatLocale("fr-fr")
+	ja := newFormatLocale("ja-jp")
+	names := []string{"Alice", "Bob", "Carol"}
+
+	assert.Equal("", en.FormatList(nil, ListConjunction, WidthLong))
+	assert.Equal("Alice", en.FormatList(names[:1], ListConjunction, WidthLong))
+	assert.Equal("Alice and Bob", en.FormatList(names[:2], ListConjunction, WidthLong))
+	assert.Equal("Alice, Bob, and Carol", en.FormatList(names, ListConjunction, WidthLong))
+	assert.Equal("Alice, Bob, Carol, and Dave", en.FormatList(append(names, "Dave"), ListConjunction, WidthLong))
+	assert.Equal("Alice、Bob和Carol", tw.FormatList(names, ListConjunction, WidthLong))
+	assert.Equal("Alice, Bob et Carol", fr.FormatList(names, ListConjunction, WidthLong))
+	assert.Equal("Alice、Bob、Carol", ja.FormatList(names, ListConjunction, WidthLong))
+
+	// Disjunctions.
+	assert.Equal("Alice, Bob, or Carol", en.FormatList(names, ListDisjunction, WidthLong))
+	assert.Equal("Alice or Bob", en.FormatList(names[:2], ListDisjunction, WidthNarrow))
+	assert.Equal("Alice、Bob或Carol", tw.FormatList(names, ListDisjunction, WidthShort))
+
+	// Widths.
+	assert.Equal("Alice, Bob, & Carol", en.FormatList(names, ListConjunction, WidthShort))
+	assert.Equal("Alice, Bob, Carol", en.FormatList(names, ListConjunction, WidthNarrow))
+
+	// Units.
+	units := []string{"3 feet", "7 inches"}
+	assert.Equal("3 feet, 7 inches", en.FormatList(units, ListUnit, WidthLong))
+	assert.Equal("3 feet, 7 inches", en.FormatList(units, ListUnit, WidthShort))
+	assert.Equal("3 feet 7 inches", en.FormatList(units, ListUnit, WidthNarrow))
+}
+
+func TestListTemplateFunc(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"test_liked":  "{{ list .Names }} liked your post",
+			"test_choose": "Choose {{ list .Numbers \"disjunction\" }}",
+		},
+		"zh-tw": map[string]string{
+			"test_liked": "{{ list .Names }}說你的貼文讚",
+		},
+	})
+	data := map[string]any{
+		"Names":   []string{"Alice", "Bob", "Carol"},
+		"Numbers": []int{1, 2, 3},
+	}
+	assert.Equal("Alice, Bob, and Carol liked your post", i.NewLocale("en-us").String("test_liked", data))
+	assert.Equal("Alice、Bob和Carol說你的貼文讚", i.NewLocale("zh-tw").String("test_liked", data))
+	assert.Equal("Choose 1, 2, or 3", i.NewLocale("en-us").String("test_choose", data))
+}
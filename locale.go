@@ -3,13 +3,35 @@ package i18n
 import (
 	"bytes"
 	"fmt"
+	"reflect"
+	"strings"
+	"sync"
 )
 
+// bufferPool reuses the buffers for rendering the templates.
+var bufferPool = sync.Pool{
+	New: func() any {
+		return new(bytes.Buffer)
+	},
+}
+
+// maxPooledBufferSize prevents the buffers that grew too large from being kept in the pool.
+const maxPooledBufferSize = 64 << 10
+
 // Locale represents a translated locale.
 type Locale struct {
 	parent *I18n
 
-	locale string
+	locale   string
+	prefix   string
+	unscoped bool
+}
+
+// WithUnscopedFallback makes a scoped locale look up the unscoped name if the scoped one doesn't exist, works with `Scope` only.
+func WithUnscopedFallback() func(*Locale) {
+	return func(l *Locale) {
+		l.unscoped = true
+	}
 }
 
 // Locale returns the current locale name.
@@ -17,7 +39,22 @@ func (l *Locale) Locale() string {
 	return l.locale
 }
 
-// String returns a translated string.
+// Scope returns a view of the locale that prepends `namespace.` to the names (e.g. `checkout.title` for `title`),
+// the scopes can be nested. The name without the namespace is output if the translation doesn't exist.
+func (l *Locale) Scope(namespace string, options ...func(*Locale)) *Locale {
+	scoped := &Locale{
+		parent:   l.parent,
+		locale:   l.locale,
+		prefix:   l.prefix + namespace + ".",
+		unscoped: l.unscoped,
+	}
+	for _, o := range options {
+		o(scoped)
+	}
+	return scoped
+}
+
+// String returns a translated string, multiple data (maps or structs) are merged and the later ones take precedence.
 func (l *Locale) String(name string, data ...any) string {
 	selectedTrans := l.lookup(name)
 	return l.render(selectedTrans.texts[0], data...)
@@ -25,44 +62,272 @@ func (l *Locale) String(name string, data ...any) string {
 
 // StringX returns a translated string with a specified context.
 func (l *Locale) StringX(name, context string, data ...any) string {
-	return l.String(fmt.Sprintf("%s <%s>", name, context), data...)
+	selectedTrans := l.lookupContext(name, context)
+	return l.render(selectedTrans.texts[0], data...)
 }
 
-// Number returns a translated string based on the `count`.
+// AppendString appends the translated string to `dst` and returns the extended buffer,
+// it doesn't allocate for the translations without the templates and the placeholders.
+func (l *Locale) AppendString(dst []byte, name string, data ...any) []byte {
+	selectedTrans := l.lookup(name)
+	return l.appendRender(dst, selectedTrans.texts[0], data...)
+}
+
+// Number returns a translated string based on the `count`, the `count` is available as `.Count`
+// and `.FormattedCount` (e.g. `1,234`) in the template unless the data has the fields with the same names.
+// A single data that isn't a map stays as the dot if the template doesn't use the count, otherwise the fields
+// of a struct are merged with the count and its methods are not available.
 func (l *Locale) Number(name string, count int, data ...any) string {
 	selectedTrans := l.lookup(name)
-	selectedIndex := selectedTrans.pluralizor(count, len(selectedTrans.texts))
-	return l.render(selectedTrans.texts[selectedIndex], data...)
+	selectedText := selectedTrans.texts[pluralIndex(selectedTrans.pluralizor, count, len(selectedTrans.texts))]
+	return l.render(selectedText, l.countData(selectedText, count, data)...)
 }
 
 // NumberX returns a translated string based on the `count` with a specified context.
 func (l *Locale) NumberX(name string, context string, count int, data ...any) string {
-	return l.Number(fmt.Sprintf("%s <%s>", name, context), count, data...)
+	selectedTrans := l.lookupContext(name, context)
+	selectedText := selectedTrans.texts[pluralIndex(selectedTrans.pluralizor, count, len(selectedTrans.texts))]
+	return l.render(selectedText, l.countData(selectedText, count, data)...)
+}
+
+// AppendNumber appends the translated string based on the `count` to `dst` and returns the extended buffer.
+func (l *Locale) AppendNumber(dst []byte, name string, count int, data ...any) []byte {
+	selectedTrans := l.lookup(name)
+	selectedText := selectedTrans.texts[pluralIndex(selectedTrans.pluralizor, count, len(selectedTrans.texts))]
+	return l.appendRender(dst, selectedText, l.countData(selectedText, count, data)...)
+}
+
+// Select returns a translated string of the variant that was picked by the `value` (e.g. `male`, `female`),
+// the variants are named like `name [female]`, and `name [other]` or `name` is used if the variant doesn't exist.
+func (l *Locale) Select(name, value string, data ...any) string {
+	selectedTrans := l.lookupVariant(name, value)
+	return l.render(selectedTrans.texts[0], data...)
+}
+
+// SelectX returns a translated string of the variant with a specified context, the variants are named like `name <context> [female]`.
+func (l *Locale) SelectX(name, context, value string, data ...any) string {
+	return l.Select(fmt.Sprintf("%s <%s>", name, context), value, data...)
+}
+
+// SelectNumber returns a translated string of the variant based on the `count`.
+func (l *Locale) SelectNumber(name, value string, count int, data ...any) string {
+	selectedTrans := l.lookupVariant(name, value)
+	selectedText := selectedTrans.texts[pluralIndex(selectedTrans.pluralizor, count, len(selectedTrans.texts))]
+	return l.render(selectedText, l.countData(selectedText, count, data)...)
+}
+
+// SelectNumberX returns a translated string of the variant based on the `count` with a specified context.
+func (l *Locale) SelectNumberX(name, context, value string, count int, data ...any) string {
+	return l.SelectNumber(fmt.Sprintf("%s <%s>", name, context), value, count, data...)
+}
+
+// lookupVariant prefers the translations of the current locale, so a variant of the fallback locale
+// won't be picked over the translation that was translated for the current locale.
+func (l *Locale) lookupVariant(name, value string) *compiledTranslation {
+	var fallbackTrans *compiledTranslation
+	for _, v := range []string{fmt.Sprintf("%s [%s]", name, value), name + " [other]", name} {
+		selectedTrans, ok := l.find(v)
+		if !ok {
+			continue
+		}
+		if selectedTrans.locale == l.locale {
+			return selectedTrans
+		}
+		if fallbackTrans == nil {
+			fallbackTrans = selectedTrans
+		}
+	}
+	if fallbackTrans != nil {
+		return fallbackTrans
+	}
+	return l.lookup(name)
+}
+
+// lookupContext uses the precomputed context keys, so the key with the context won't be built unless it's missing.
+func (l *Locale) lookupContext(name, context string) *compiledTranslation {
+	contexts := l.parent.compiled.Load().contexts[l.locale]
+	if l.prefix != "" {
+		if selectedTrans, ok := contexts[contextKey{l.prefix + name, context}]; ok {
+			return selectedTrans
+		}
+	}
+	if l.prefix == "" || l.unscoped {
+		if selectedTrans, ok := contexts[contextKey{name, context}]; ok {
+			return selectedTrans
+		}
+	}
+	return l.lookupRuntime(name + " <" + context + ">")
+}
+
+// find looks up the translation of the locale with the scope.
+func (l *Locale) find(name string) (*compiledTranslation, bool) {
+	translations := l.parent.compiled.Load().translations[l.locale]
+	if l.prefix != "" {
+		if selectedTrans, ok := translations[l.prefix+name]; ok {
+			return selectedTrans, true
+		}
+		if !l.unscoped {
+			return nil, false
+		}
+	}
+	selectedTrans, ok := translations[name]
+	return selectedTrans, ok
 }
 
 // lookup
 func (l *Locale) lookup(name string) *compiledTranslation {
-	if selectedTrans, ok := l.parent.compiledTranslations[l.locale][name]; ok {
+	if selectedTrans, ok := l.find(name); ok {
 		return selectedTrans
 	}
+	return l.lookupRuntime(name)
+}
+
+// lookupRuntime compiles the name as the translation for the missing translations.
+func (l *Locale) lookupRuntime(name string) *compiledTranslation {
+	l.parent.runtimeMutex.RLock()
 	runtimeTrans, ok := l.parent.runtimeCompiledTranslations[name]
-	if !ok {
-		runtimeTrans = l.parent.compileTranslation(l.parent.defaultLocale, name, trimContext(name))
+	l.parent.runtimeMutex.RUnlock()
+	if ok {
+		return runtimeTrans
+	}
+	runtimeTrans = l.parent.compileTranslation(l.parent.defaultLocale, name, trimContext(name))
+
+	l.parent.runtimeMutex.Lock()
+	defer l.parent.runtimeMutex.Unlock()
+	if v, ok := l.parent.runtimeCompiledTranslations[name]; ok {
+		return v
 	}
 	l.parent.runtimeCompiledTranslations[name] = runtimeTrans
 	return runtimeTrans
 }
 
+// countData returns the data with the `Count` and the `FormattedCount` for rendering the text,
+// the data is returned as-is if the text doesn't need it.
+func (l *Locale) countData(text *compiledText, count int, data []any) []any {
+	switch {
+	// The printf-style placeholders use the `count` as the argument if there is no data.
+	case text.placeholders != nil && l.parent.placeholder == PlaceholderPrintf:
+		if len(data) == 0 {
+			return []any{count}
+		}
+		return data
+	case !text.template && text.placeholders == nil:
+		return data
+	// A single value that isn't a map stays as the dot, so the methods of the structs and the values like strings still work.
+	case text.template && len(data) == 1 && !isMapData(data[0]) && !usesCount(text.text):
+		return data
+	}
+	countData := map[string]any{
+		"Count":          count,
+		"FormattedCount": l.FormatNumber(count),
+	}
+	return append([]any{countData}, data...)
+}
+
+// usesCount reports whether the template uses `.Count` or `.FormattedCount`.
+func usesCount(text string) bool {
+	return strings.Contains(text, ".Count") || strings.Contains(text, ".FormattedCount")
+}
+
 // render
 func (l *Locale) render(text *compiledText, data ...any) string {
-	if text.tmpl != nil {
-		var tpl bytes.Buffer
-		if len(data) > 0 {
-			text.tmpl.Execute(&tpl, data[0])
-		} else {
-			text.tmpl.Execute(&tpl, nil)
+	if !text.template && text.placeholders == nil {
+		return text.text
+	}
+	b := bufferPool.Get().(*bytes.Buffer)
+	l.execute(b, text, data)
+	v := b.String()
+	putBuffer(b)
+	return v
+}
+
+// appendRender
+func (l *Locale) appendRender(dst []byte, text *compiledText, data ...any) []byte {
+	if !text.template && text.placeholders == nil {
+		return append(dst, text.text...)
+	}
+	b := bufferPool.Get().(*bytes.Buffer)
+	l.execute(b, text, data)
+	dst = append(dst, b.Bytes()...)
+	putBuffer(b)
+	return dst
+}
+
+// execute writes the rendered template or the replaced placeholders to the buffer,
+// the raw text is written if the template is invalid.
+func (l *Locale) execute(b *bytes.Buffer, text *compiledText, data []any) {
+	if text.placeholders != nil {
+		writePlaceholders(b, text.placeholders, data)
+		return
+	}
+	tmpl := text.localize(l)
+	if tmpl == nil {
+		b.WriteString(text.text)
+		return
+	}
+	tmpl.Execute(b, mergeData(data...))
+}
+
+// putBuffer
+func putBuffer(b *bytes.Buffer) {
+	if b.Cap() > maxPooledBufferSize {
+		return
+	}
+	b.Reset()
+	bufferPool.Put(b)
+}
+
+// isMapData reports whether the data is a map with the string keys, which can be merged without losing anything.
+func isMapData(v any) bool {
+	if _, ok := v.(map[string]any); ok {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Map && rv.Type().Key().Kind() == reflect.String
+}
+
+// mergeData returns the only data as-is, or merges the fields of the maps and the structs into a map.
+// The later data take precedence, and the data that are neither maps nor structs are ignored.
+func mergeData(data ...any) any {
+	switch len(data) {
+	case 0:
+		return nil
+	case 1:
+		return data[0]
+	}
+	merged := make(map[string]any)
+	for _, v := range data {
+		if m, ok := v.(map[string]any); ok {
+			for k, v := range m {
+				merged[k] = v
+			}
+			continue
+		}
+		rv := reflect.ValueOf(v)
+		for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+			rv = rv.Elem()
+		}
+		switch rv.Kind() {
+		case reflect.Map:
+			if rv.Type().Key().Kind() != reflect.String {
+				continue
+			}
+			iter := rv.MapRange()
+			for iter.Next() {
+				merged[iter.Key().String()] = iter.Value().Interface()
+			}
+		case reflect.Struct:
+			for _, f := range reflect.VisibleFields(rv.Type()) {
+				if !f.IsExported() || f.Anonymous {
+					continue
+				}
+				field, err := rv.FieldByIndexErr(f.Index)
+				if err != nil {
+					continue
+				}
+				merged[f.Name] = field.Interface()
+			}
 		}
-		return tpl.String()
 	}
-	return text.text
+	return merged
 }
@@ -0,0 +1,97 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testUser struct {
+	Name  string
+	Email string
+	Count int
+	note  string
+}
+
+func (u testUser) Greet() string {
+	return "hi " + u.Name
+}
+
+type testMember struct {
+	testUser
+	Role string
+}
+
+func TestMergeData(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"test_profile": "{{ .Name }} <{{ .Email }}> ({{ .Role }})",
+		},
+	})
+	l := i.NewLocale("en-us")
+
+	user := testUser{
+		Name:  "Yami",
+		Email: "yami@example.com",
+	}
+	assert.Equal("Yami <yami@example.com> (Admin)", l.String("test_profile", user, map[string]any{"Role": "Admin"}))
+	assert.Equal("Yami <yami@example.com> (Admin)", l.String("test_profile", &user, map[string]string{"Role": "Admin"}))
+	assert.Equal("Rin <yami@example.com> (Admin)", l.String("test_profile", user, map[string]any{"Role": "Admin"}, map[string]any{"Name": "Rin"}))
+	assert.Equal("Yami <yami@example.com> (Owner)", l.String("test_profile", testMember{testUser: user, Role: "Owner"}, nil))
+	assert.Equal("Yami <yami@example.com> (<no value>)", l.String("test_profile", user, 123))
+
+	assert.Equal(map[string]any{"A": 2, "B": 3}, mergeData(map[string]int{"A": 1}, map[string]int{"A": 2, "B": 3}))
+	assert.Equal(map[string]any{"Name": "Yami", "Email": "", "Count": 0, "Role": "Admin"}, mergeData(testMember{testUser: testUser{Name: "Yami", note: "hidden"}, Role: "Admin"}, nil))
+	assert.Nil(mergeData())
+}
+
+func TestNumberCount(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"test_apples":  "No apples | 1 apple | {{ .Count }} apples",
+			"test_views":   "No views | 1 view | {{ .FormattedCount }} views",
+			"test_friends": "No friends | {{ .Name }} has 1 friend | {{ .Name }} has {{ .Count }} friends",
+		},
+		"de-de": map[string]string{
+			"test_views": "Keine Aufrufe | 1 Aufruf | {{ .FormattedCount }} Aufrufe",
+		},
+	})
+	l := i.NewLocale("en-us")
+
+	assert.Equal("3 apples", l.Number("test_apples", 3))
+	assert.Equal("1,234 views", l.Number("test_views", 1234))
+	assert.Equal("1.234 Aufrufe", i.NewLocale("de-de").Number("test_views", 1234))
+	assert.Equal("Yami has 3 friends", l.Number("test_friends", 3, map[string]any{"Name": "Yami"}))
+	assert.Equal("Yami has 3 friends", l.Number("test_friends", 3, testUser{Name: "Yami", Count: 3}))
+	assert.Equal("Yami has 3 friends", l.Number("test_friends", 3, struct{ Name string }{Name: "Yami"}))
+
+	// The data take precedence over the count.
+	assert.Equal("three apples", l.Number("test_apples", 3, map[string]any{"Count": "three"}))
+	assert.Equal("Yami has 5 friends", l.Number("test_friends", 3, testUser{Name: "Yami", Count: 5}))
+	assert.Equal("Yami has 3 friends", l.SelectNumber("test_friends", "male", 3, map[string]any{"Name": "Yami"}))
+}
+
+func TestNumberSingleData(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"test_greet":  "{{ .Greet }} | {{ .Greet }}!",
+			"test_scalar": "{{ . }} | {{ . }}s",
+		},
+	})
+	l := i.NewLocale("en-us")
+
+	// The struct keeps its methods and the scalar stays as the dot.
+	assert.Equal("hi Yami!", l.Number("test_greet", 2, testUser{Name: "Yami"}))
+	assert.Equal("hi Yami!", l.Number("test_greet", 2, &testUser{Name: "Yami"}))
+	assert.Equal("foos", l.Number("test_scalar", 2, "foo"))
+	assert.Equal("foo", l.Number("test_scalar", 1, "foo"))
+}
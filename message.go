@@ -0,0 +1,111 @@
+package i18n
+
+import "context"
+
+// Message is a translation that will be translated later when the locale is known,
+// so it can be declared as a package-level variable (e.g. a validation message) or stored in a struct.
+type Message struct {
+	name    string
+	context string
+	count   int
+	plural  bool
+	data    []any
+}
+
+// NewMessage creates a message that will be translated like `Locale.String`.
+func NewMessage(name string, data ...any) Message {
+	return Message{
+		name: name,
+		data: data,
+	}
+}
+
+// NewMessageX creates a message with a specified context that will be translated like `Locale.StringX`.
+func NewMessageX(name, context string, data ...any) Message {
+	return Message{
+		name:    name,
+		context: context,
+		data:    data,
+	}
+}
+
+// NewNumberMessage creates a message that will be translated based on the `count` like `Locale.Number`.
+func NewNumberMessage(name string, count int, data ...any) Message {
+	return Message{
+		name:   name,
+		count:  count,
+		plural: true,
+		data:   data,
+	}
+}
+
+// NewNumberMessageX creates a message with a specified context that will be translated based on the `count` like `Locale.NumberX`.
+func NewNumberMessageX(name, context string, count int, data ...any) Message {
+	return Message{
+		name:    name,
+		context: context,
+		count:   count,
+		plural:  true,
+		data:    data,
+	}
+}
+
+// Name returns the name of the message.
+func (m Message) Name() string {
+	return m.name
+}
+
+// WithData returns a copy of the message with the data, the data are merged after the data of the message.
+func (m Message) WithData(data ...any) Message {
+	m.data = append(m.data[:len(m.data):len(m.data)], data...)
+	return m
+}
+
+// WithCount returns a copy of the message that will be translated based on the `count`.
+func (m Message) WithCount(count int) Message {
+	m.count = count
+	m.plural = true
+	return m
+}
+
+// Translate translates the message with the locale, the name is returned if the locale is nil.
+func (m Message) Translate(l *Locale) string {
+	if l == nil {
+		return m.name
+	}
+	switch {
+	case m.plural && m.context != "":
+		return l.NumberX(m.name, m.context, m.count, m.data...)
+	case m.plural:
+		return l.Number(m.name, m.count, m.data...)
+	case m.context != "":
+		return l.StringX(m.name, m.context, m.data...)
+	default:
+		return l.String(m.name, m.data...)
+	}
+}
+
+// TranslateContext translates the message with the locale of the context that was stored by `NewContext`.
+func (m Message) TranslateContext(ctx context.Context) string {
+	l, _ := FromContext(ctx)
+	return m.Translate(l)
+}
+
+// String returns the name of the message since the locale is unknown, use `Translate` to translate it.
+func (m Message) String() string {
+	return m.name
+}
+
+// localeContextKey
+type localeContextKey struct{}
+
+// NewContext returns a copy of the context that carries the locale.
+func NewContext(ctx context.Context, l *Locale) context.Context {
+	return context.WithValue(ctx, localeContextKey{}, l)
+}
+
+// FromContext returns the locale that was stored in the context by `NewContext`.
+func FromContext(ctx context.Context) (*Locale, bool) {
+	l, ok := ctx.Value(localeContextKey{}).(*Locale)
+	return l, ok && l != nil
+}
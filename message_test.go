@@ -0,0 +1,65 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testRequired = NewMessage("validation.required")
+	testTooLong  = NewMessage("validation.too_long", map[string]any{"Max": 20})
+	testPost     = NewMessageX("Post", "noun")
+	testApples   = NewNumberMessage("test_apples", 3)
+	testPosts    = NewNumberMessageX("{{ .Count }} Posts", "noun", 2)
+)
+
+func TestMessage(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"validation.required": "This field is required.",
+			"validation.too_long": "{{ .Field }} should be at most {{ .Max }} characters.",
+			"test_apples":         "No apples | 1 apple | {{ .Count }} apples",
+		},
+		"zh-tw": map[string]string{
+			"validation.required":       "此欄位為必填。",
+			"validation.too_long":       "{{ .Field }} 最多只能有 {{ .Max }} 個字。",
+			"Post <noun>":               "文章",
+			"test_apples":               "沒有蘋果 | 1 顆蘋果 | {{ .Count }} 顆蘋果",
+			"{{ .Count }} Posts <noun>": "{{ .Count }} 篇文章",
+		},
+	})
+	en := i.NewLocale("en-us")
+	tw := i.NewLocale("zh-tw")
+
+	assert.Equal("This field is required.", testRequired.Translate(en))
+	assert.Equal("此欄位為必填。", testRequired.Translate(tw))
+	assert.Equal("Name 最多只能有 20 個字。", testTooLong.WithData(map[string]any{"Field": "Name"}).Translate(tw))
+	assert.Equal("<no value> 最多只能有 20 個字。", testTooLong.Translate(tw))
+	assert.Equal("文章", testPost.Translate(tw))
+	assert.Equal("Post", testPost.Translate(en))
+	assert.Equal("3 apples", testApples.Translate(en))
+	assert.Equal("1 顆蘋果", testApples.WithCount(1).Translate(tw))
+	assert.Equal("2 篇文章", testPosts.Translate(tw))
+	assert.Equal("2 Posts", testPosts.Translate(en))
+
+	// Without a locale.
+	assert.Equal("validation.required", testRequired.Translate(nil))
+	assert.Equal("validation.required", fmt.Sprint(testRequired))
+	assert.Equal("validation.required", testRequired.Name())
+
+	// Context.
+	ctx := NewContext(context.Background(), tw)
+	l, ok := FromContext(ctx)
+	assert.True(ok)
+	assert.Equal(tw, l)
+	assert.Equal("此欄位為必填。", testRequired.TranslateContext(ctx))
+	assert.Equal("validation.required", testRequired.TranslateContext(context.Background()))
+	_, ok = FromContext(context.Background())
+	assert.False(ok)
+}
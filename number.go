@@ -0,0 +1,307 @@
+package i18n
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// NumberFormat describes how a number should be formatted.
+type NumberFormat struct {
+	minFractionDigits int
+	maxFractionDigits int
+	fractionDigits    bool
+	grouping          bool
+	percent           bool
+	scientific        bool
+	native            bool
+	currencyDisplay   CurrencyDisplay
+	accounting        bool
+	cash              bool
+	compactLong       bool
+}
+
+// WithFractionDigits changes the minimum and the maximum fraction digits, defaults to `0` and `3`.
+// The negative digits are treated as `0`.
+func WithFractionDigits(min, max int) func(*NumberFormat) {
+	return func(f *NumberFormat) {
+		f.minFractionDigits = min
+		f.maxFractionDigits = max
+		f.fractionDigits = true
+		if f.minFractionDigits < 0 {
+			f.minFractionDigits = 0
+		}
+		if f.maxFractionDigits < f.minFractionDigits {
+			f.maxFractionDigits = f.minFractionDigits
+		}
+	}
+}
+
+// WithoutGrouping disables the grouping separators, so `1234567` won't be formatted as `1,234,567`.
+func WithoutGrouping() func(*NumberFormat) {
+	return func(f *NumberFormat) {
+		f.grouping = false
+	}
+}
+
+// WithPercent multiplies the number by 100 and formats it as a percentage (e.g. `12%`).
+func WithPercent() func(*NumberFormat) {
+	return func(f *NumberFormat) {
+		f.percent = true
+	}
+}
+
+// WithScientific formats the number in the scientific notation (e.g. `1.234E6`).
+func WithScientific() func(*NumberFormat) {
+	return func(f *NumberFormat) {
+		f.scientific = true
+	}
+}
+
+// WithNativeDigits uses the native digits of the locale if there is one (e.g. Arabic-Indic digits `١٢٣`).
+func WithNativeDigits() func(*NumberFormat) {
+	return func(f *NumberFormat) {
+		f.native = true
+	}
+}
+
+// newNumberFormat
+func newNumberFormat(options ...func(*NumberFormat)) *NumberFormat {
+	f := &NumberFormat{
+		maxFractionDigits: 3,
+		grouping:          true,
+	}
+	for _, o := range options {
+		o(f)
+	}
+	return f
+}
+
+// FormatNumber formats a number (e.g. `int`, `float64`) with the conventions of the locale,
+// such as `1,234,567.89`, `1.234.567,89` or `12,34,567.89`.
+func (l *Locale) FormatNumber(v any, options ...func(*NumberFormat)) string {
+	f := newNumberFormat(options...)
+	d := lookupLocaleData(l.locale)
+	s := d.symbols(f.native)
+
+	n, ok := parseDecimal(v)
+	if !ok {
+		return fmt.Sprint(v)
+	}
+	if f.percent {
+		n = n.shift(2)
+	}
+	if f.scientific {
+		return formatScientific(d, s, n, f)
+	}
+	text := formatDecimal(d, s, n, f)
+	if f.percent {
+		return strings.Replace(s.percent, "{0}", text, 1)
+	}
+	return text
+}
+
+// decimal is a number that was split into digits, so the integers won't lose the precision.
+type decimal struct {
+	negative bool
+	integer  string
+	fraction string
+	special  string
+}
+
+// parseDecimal converts the integers and the floats to a decimal.
+func parseDecimal(v any) (decimal, bool) {
+	var s string
+	switch n := v.(type) {
+	case int:
+		s = strconv.FormatInt(int64(n), 10)
+	case int8:
+		s = strconv.FormatInt(int64(n), 10)
+	case int16:
+		s = strconv.FormatInt(int64(n), 10)
+	case int32:
+		s = strconv.FormatInt(int64(n), 10)
+	case int64:
+		s = strconv.FormatInt(n, 10)
+	case uint:
+		s = strconv.FormatUint(uint64(n), 10)
+	case uint8:
+		s = strconv.FormatUint(uint64(n), 10)
+	case uint16:
+		s = strconv.FormatUint(uint64(n), 10)
+	case uint32:
+		s = strconv.FormatUint(uint64(n), 10)
+	case uint64:
+		s = strconv.FormatUint(n, 10)
+	case float32:
+		return floatDecimal(float64(n), 32), true
+	case float64:
+		return floatDecimal(n, 64), true
+	default:
+		return decimal{}, false
+	}
+	d := decimal{}
+	if strings.HasPrefix(s, "-") {
+		d.negative, s = true, s[1:]
+	}
+	d.integer = s
+	return d, true
+}
+
+// floatDecimal
+func floatDecimal(v float64, bitSize int) decimal {
+	switch {
+	case math.IsNaN(v):
+		return decimal{special: "NaN"}
+	case math.IsInf(v, 0):
+		return decimal{special: "∞", negative: v < 0}
+	}
+	s := strconv.FormatFloat(v, 'f', -1, bitSize)
+	d := decimal{}
+	if strings.HasPrefix(s, "-") {
+		d.negative, s = true, s[1:]
+	}
+	d.integer, d.fraction, _ = strings.Cut(s, ".")
+	return d
+}
+
+// shift moves the decimal point to the right by `n` digits, or to the left if `n` is negative.
+func (d decimal) shift(n int) decimal {
+	if d.special != "" {
+		return d
+	}
+	if n < 0 {
+		integer := strings.Repeat("0", -n) + d.integer
+		d.integer = strings.TrimLeft(integer[:len(integer)+n], "0")
+		if d.integer == "" {
+			d.integer = "0"
+		}
+		d.fraction = strings.TrimRight(integer[len(integer)+n:]+d.fraction, "0")
+		return d
+	}
+	fraction := d.fraction + strings.Repeat("0", n)
+	d.integer = strings.TrimLeft(d.integer+fraction[:n], "0")
+	if d.integer == "" {
+		d.integer = "0"
+	}
+	d.fraction = strings.TrimRight(fraction[n:], "0")
+	return d
+}
+
+// round rounds the fraction to `max` digits with the half-even rule, and pads it to `min` digits.
+func (d decimal) round(min, max int) decimal {
+	if d.special != "" {
+		return d
+	}
+	if len(d.fraction) > max {
+		digits := []byte(d.integer + d.fraction[:max])
+		next, rest := d.fraction[max], strings.TrimRight(d.fraction[max+1:], "0")
+		up := next > '5' || (next == '5' && rest != "") || (next == '5' && (digits[len(digits)-1]-'0')%2 == 1)
+		if up {
+			j := len(digits) - 1
+			for ; j >= 0; j-- {
+				if digits[j] == '9' {
+					digits[j] = '0'
+					continue
+				}
+				digits[j]++
+				break
+			}
+			if j < 0 {
+				digits = append([]byte{'1'}, digits...)
+			}
+		}
+		d.integer, d.fraction = string(digits[:len(digits)-max]), string(digits[len(digits)-max:])
+	}
+	d.fraction = strings.TrimRight(d.fraction, "0")
+	if len(d.fraction) < min {
+		d.fraction += strings.Repeat("0", min-len(d.fraction))
+	}
+	if strings.Trim(d.integer+d.fraction, "0") == "" {
+		d.negative = false
+	}
+	return d
+}
+
+// formatDecimal formats the decimal with the grouping, the decimal separator and the digits of the locale.
+func formatDecimal(d *localeData, s *numberSymbols, n decimal, f *NumberFormat) string {
+	var b strings.Builder
+	n = n.round(f.minFractionDigits, f.maxFractionDigits)
+	if n.negative {
+		b.WriteString(s.minus)
+	}
+	if n.special != "" {
+		b.WriteString(n.special)
+		return b.String()
+	}
+
+	integer := n.integer
+	if f.grouping && len(integer) >= d.primaryGrouping+d.minimumGrouping {
+		var groups []string
+		groups = append(groups, integer[len(integer)-d.primaryGrouping:])
+		integer = integer[:len(integer)-d.primaryGrouping]
+		for len(integer) > d.secondaryGrouping {
+			groups = append([]string{integer[len(integer)-d.secondaryGrouping:]}, groups...)
+			integer = integer[:len(integer)-d.secondaryGrouping]
+		}
+		groups = append([]string{integer}, groups...)
+		integer = strings.Join(groups, s.group)
+	}
+	b.WriteString(integer)
+	if n.fraction != "" {
+		b.WriteString(s.decimal)
+		b.WriteString(n.fraction)
+	}
+	return localizeDigits(s, b.String())
+}
+
+// formatScientific formats the decimal like `1.234E6`.
+func formatScientific(d *localeData, s *numberSymbols, n decimal, f *NumberFormat) string {
+	if n.special != "" {
+		return formatDecimal(d, s, n, f)
+	}
+	digits := strings.TrimLeft(n.integer+n.fraction, "0")
+	exponent := len(n.integer) - 1
+	if strings.TrimLeft(n.integer, "0") == "" {
+		exponent = -(len(n.fraction) - len(strings.TrimLeft(n.fraction, "0"))) - 1
+	}
+	if digits == "" {
+		digits, exponent = "0", 0
+	}
+	mantissa := decimal{
+		negative: n.negative,
+		integer:  digits[:1],
+		fraction: digits[1:],
+	}.round(f.minFractionDigits, f.maxFractionDigits)
+	if len(mantissa.integer) > 1 {
+		mantissa.integer, mantissa.fraction = mantissa.integer[:1], strings.TrimRight(mantissa.integer[1:]+mantissa.fraction, "0")
+		mantissa = mantissa.round(f.minFractionDigits, f.maxFractionDigits)
+		exponent++
+	}
+	text := formatDecimal(d, s, mantissa, &NumberFormat{
+		minFractionDigits: f.minFractionDigits,
+		maxFractionDigits: f.maxFractionDigits,
+	})
+	var exp string
+	if exponent < 0 {
+		exp = s.minus + strconv.Itoa(-exponent)
+	} else {
+		exp = strconv.Itoa(exponent)
+	}
+	return text + s.exponent + localizeDigits(s, exp)
+}
+
+// localizeDigits replaces the ASCII digits with the digits of the numbering system.
+func localizeDigits(s *numberSymbols, v string) string {
+	if s.digits == latinSymbols.digits {
+		return v
+	}
+	digits := []rune(s.digits)
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return digits[r-'0']
+		}
+		return r
+	}, v)
+}
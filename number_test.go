@@ -0,0 +1,105 @@
+package i18n
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newFormatLocale creates a locale without translations for testing the formatters.
+func newFormatLocale(locale string) *Locale {
+	i := New(locale)
+	i.LoadMap(map[string]map[string]string{
+		locale: map[string]string{},
+	})
+	return i.NewLocale(locale)
+}
+
+func TestFormatNumber(t *testing.T) {
+	assert := assert.New(t)
+
+	en := newFormatLocale("en-us")
+	de := newFormatLocale("de-de")
+	fr := newFormatLocale("fr-fr")
+	hi := newFormatLocale("en-in")
+	es := newFormatLocale("es-es")
+	ar := newFormatLocale("ar-eg")
+
+	assert.Equal("1,234,567", en.FormatNumber(1234567))
+	assert.Equal("1.234.567", de.FormatNumber(1234567))
+	assert.Equal("1\u202f234\u202f567", fr.FormatNumber(1234567))
+	assert.Equal("12,34,567", hi.FormatNumber(1234567))
+	assert.Equal("-9,223,372,036,854,775,808", en.FormatNumber(int64(math.MinInt64)))
+	assert.Equal("18,446,744,073,709,551,615", en.FormatNumber(uint64(math.MaxUint64)))
+
+	// Minimum grouping digits.
+	assert.Equal("1234", es.FormatNumber(1234))
+	assert.Equal("12.345", es.FormatNumber(12345))
+
+	// Fraction digits.
+	assert.Equal("1,234.568", en.FormatNumber(1234.5678))
+	assert.Equal("1.234,5678", de.FormatNumber(1234.5678, WithFractionDigits(0, 4)))
+	assert.Equal("1,234.50", en.FormatNumber(1234.5, WithFractionDigits(2, 2)))
+	assert.Equal("1,234", en.FormatNumber(1234.5, WithFractionDigits(0, 0)))
+	assert.Equal("1,236", en.FormatNumber(1235.5, WithFractionDigits(0, 0)))
+	assert.Equal("1,234", en.FormatNumber(1234.5, WithFractionDigits(-1, -1)))
+	assert.Equal("1,234.5", en.FormatNumber(1234.5, WithFractionDigits(-1, 2)))
+	assert.Equal("1,000", en.FormatNumber(999.9999))
+	assert.Equal("0", en.FormatNumber(-0.0001))
+	assert.Equal("1234567", en.FormatNumber(1234567, WithoutGrouping()))
+
+	// Percent.
+	assert.Equal("12%", en.FormatNumber(0.12, WithPercent()))
+	assert.Equal("12,5\u00a0%", de.FormatNumber(0.125, WithPercent(), WithFractionDigits(1, 1)))
+
+	// Scientific.
+	assert.Equal("1.235E6", en.FormatNumber(1234567, WithScientific()))
+	assert.Equal("1,2E-3", de.FormatNumber(0.0012, WithScientific()))
+	assert.Equal("1E1", en.FormatNumber(9.9999, WithScientific(), WithFractionDigits(0, 2)))
+
+	// Native digits.
+	assert.Equal("1,234,567.5", ar.FormatNumber(1234567.5))
+	assert.Equal("١٬٢٣٤٬٥٦٧٫٥", ar.FormatNumber(1234567.5, WithNativeDigits()))
+	assert.Equal("1,234", en.FormatNumber(1234, WithNativeDigits()))
+
+	// Special values.
+	assert.Equal("NaN", en.FormatNumber(math.NaN()))
+	assert.Equal("-∞", en.FormatNumber(math.Inf(-1)))
+	assert.Equal("abc", en.FormatNumber("abc"))
+}
+
+func TestNumberTemplateFuncs(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us", WithFallback(map[string][]string{
+		"de-de": []string{"en-us"},
+	}))
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"test_number":  "{{ number .Count }} items, {{ decimal .Price 2 }} each, {{ percent .Ratio }} off",
+			"test_plural":  "None | One item | {{ number .Count }} items",
+			"test_science": "{{ scientific .Distance }} km",
+		},
+		"de-de": map[string]string{
+			"test_plural": "Keine | Ein Artikel | {{ number .Count }} Artikel",
+		},
+	})
+	data := map[string]any{
+		"Count": 1234,
+		"Price": 5.5,
+		"Ratio": 0.25,
+	}
+	assert.Equal("1,234 items, 5.50 each, 25% off", i.NewLocale("en-us").String("test_number", data))
+	assert.Equal("1.234 Artikel", i.NewLocale("de-de").Number("test_plural", 1234, data))
+	assert.Equal("6", i.NewLocale("en-us").String("{{ decimal .Price -1 }}", data))
+
+	// The fallback translation is still formatted with the current locale.
+	assert.Equal("1.234 items, 5,50 each, 25\u00a0% off", i.NewLocale("de-de").String("test_number", data))
+	assert.Equal("1,496E8 km", i.NewLocale("de-de").String("test_science", map[string]any{
+		"Distance": 149600000,
+	}))
+
+	// Runtime translations.
+	assert.Equal("1.234 Äpfel", i.NewLocale("de-de").String("{{ number .Count }} Äpfel", data))
+}
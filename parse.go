@@ -0,0 +1,574 @@
+package i18n
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ParseFormat describes how a user input should be parsed.
+type ParseFormat struct {
+	lenient  bool
+	location *time.Location
+}
+
+// WithLenient accepts the inputs that don't exactly follow the conventions of the locale,
+// such as the misplaced grouping separators, the surrounding spaces, the missing symbols or the other date separators.
+func WithLenient() func(*ParseFormat) {
+	return func(f *ParseFormat) {
+		f.lenient = true
+	}
+}
+
+// WithLocation parses the dates in the location, defaults to UTC like `time.Parse`.
+func WithLocation(loc *time.Location) func(*ParseFormat) {
+	return func(f *ParseFormat) {
+		f.location = loc
+	}
+}
+
+// newParseFormat
+func newParseFormat(options ...func(*ParseFormat)) *ParseFormat {
+	f := &ParseFormat{
+		location: time.UTC,
+	}
+	for _, o := range options {
+		o(f)
+	}
+	return f
+}
+
+// ParseError is returned when a user input couldn't be parsed, the error message is in the language of the locale.
+type ParseError struct {
+	// Input is the text that couldn't be parsed.
+	Input string
+	// Expected is an example of the expected format (e.g. `1,234.56`).
+	Expected string
+
+	message string
+}
+
+// Error returns the localized error message, such as `"1.234,56" is not a valid number, expected a number like "1,234.56"`.
+func (e *ParseError) Error() string {
+	return e.message
+}
+
+// parseError
+func (l *Locale) parseError(kind, input, expected string) error {
+	message := lookupLocale(parseMessages, l.locale)[kind]
+	return &ParseError{
+		Input:    input,
+		Expected: expected,
+		message:  strings.NewReplacer("{0}", input, "{1}", expected).Replace(message),
+	}
+}
+
+// ParseNumber parses a number that was written with the conventions of the locale (e.g. `1.234,56` in German).
+// The grouping separators are optional, but they have to be placed correctly unless `WithLenient` was used.
+func (l *Locale) ParseNumber(text string, options ...func(*ParseFormat)) (float64, error) {
+	f := newParseFormat(options...)
+	if v, ok := parseLocaleNumber(lookupLocaleData(l.locale), text, f.lenient); ok {
+		return strconv.ParseFloat(v, 64)
+	}
+	return 0, l.parseError("number", text, l.FormatNumber(1234.56))
+}
+
+// ParsePercent parses a percentage that was written with the conventions of the locale (e.g. `12,5 %` in German),
+// the result is divided by 100 (e.g. `0.125`). The percent sign is optional if `WithLenient` was used.
+func (l *Locale) ParsePercent(text string, options ...func(*ParseFormat)) (float64, error) {
+	f := newParseFormat(options...)
+	d := lookupLocaleData(l.locale)
+	for _, s := range localeSymbols(d) {
+		var number string
+		if f.lenient {
+			number = strings.TrimFunc(strings.TrimSpace(text), func(r rune) bool {
+				return r == '%' || r == '٪' || unicode.IsSpace(r)
+			})
+		} else {
+			prefix, suffix, _ := strings.Cut(s.percent, "{0}")
+			var ok bool
+			if number, ok = trimAffixes(text, prefix, suffix); !ok {
+				continue
+			}
+		}
+		if v, ok := parseNumberSymbols(d, s, number, f.lenient); ok {
+			return strconv.ParseFloat(v+"e-2", 64)
+		}
+	}
+	return 0, l.parseError("percent", text, l.FormatNumber(0.125, WithPercent(), WithFractionDigits(1, 1)))
+}
+
+// ParseCurrency parses an amount of the ISO 4217 currency (e.g. `NT$1,200.00`) that was written with the conventions of the locale.
+// The symbol, the narrow symbol or the code of the currency is required unless `WithLenient` was used,
+// the negative amounts are written with the minus sign or in the accounting format (e.g. `($1,200.00)`).
+func (l *Locale) ParseCurrency(text, currency string, options ...func(*ParseFormat)) (float64, error) {
+	f := newParseFormat(options...)
+	d := lookupLocaleData(l.locale)
+	code := strings.ToUpper(currency)
+	info, ok := currencies[code]
+	if !ok {
+		info.symbol, info.narrowSymbol = code, code
+	}
+	symbols := []string{info.symbol, info.narrowSymbol, code}
+	if v, ok := d.currencySymbols[code]; ok {
+		symbols = append([]string{v}, symbols...)
+	}
+
+	for _, s := range localeSymbols(d) {
+		if f.lenient {
+			number, negative := strings.TrimSpace(text), false
+			if strings.HasPrefix(number, "(") && strings.HasSuffix(number, ")") {
+				number, negative = number[1:len(number)-1], true
+			}
+			for _, symbol := range symbols {
+				if len(number) >= len(symbol) && strings.EqualFold(number[:len(symbol)], symbol) {
+					number = number[len(symbol):]
+				} else if len(number) >= len(symbol) && strings.EqualFold(number[len(number)-len(symbol):], symbol) {
+					number = number[:len(number)-len(symbol)]
+				}
+				number = strings.TrimFunc(number, unicode.IsSpace)
+			}
+			if v, ok := parseNumberSymbols(d, s, number, true); ok {
+				if negative {
+					v = "-" + strings.TrimPrefix(v, "-")
+				}
+				return strconv.ParseFloat(v, 64)
+			}
+			continue
+		}
+		for _, symbol := range symbols {
+			patterns := []string{d.currency, s.minus + d.currency}
+			if d.accounting != "" {
+				patterns = append(patterns, d.accounting)
+			}
+			for j, pattern := range patterns {
+				prefix, suffix, _ := strings.Cut(applyCurrencyPattern(pattern, symbol, "\x00"), "\x00")
+				number, ok := trimAffixes(text, prefix, suffix)
+				if !ok {
+					continue
+				}
+				v, ok := parseNumberSymbols(d, s, number, false)
+				if !ok || strings.HasPrefix(v, "-") {
+					continue
+				}
+				if j > 0 {
+					v = "-" + v
+				}
+				return strconv.ParseFloat(v, 64)
+			}
+		}
+	}
+	return 0, l.parseError("currency", text, l.FormatCurrency(1234.56, code))
+}
+
+// spaceReplacer replaces the no-break spaces with the ASCII spaces, since they look the same and most people type the ASCII ones.
+var spaceReplacer = strings.NewReplacer(nbsp, " ", nnbsp, " ")
+
+// trimAffixes trims the prefix and the suffix of the pattern from the text, the ASCII spaces
+// and the no-break spaces are treated as the same.
+func trimAffixes(text, prefix, suffix string) (string, bool) {
+	text, prefix, suffix = spaceReplacer.Replace(text), spaceReplacer.Replace(prefix), spaceReplacer.Replace(suffix)
+	if !strings.HasPrefix(text, prefix) || !strings.HasSuffix(text, suffix) || len(text) < len(prefix)+len(suffix) {
+		return "", false
+	}
+	return text[len(prefix) : len(text)-len(suffix)], true
+}
+
+// localeSymbols returns the latin symbols and the native symbols of the locale if there is one.
+func localeSymbols(d *localeData) []*numberSymbols {
+	if d.native != nil {
+		return []*numberSymbols{&d.latin, d.native}
+	}
+	return []*numberSymbols{&d.latin}
+}
+
+// parseLocaleNumber parses the number with the latin symbols or the native symbols of the locale,
+// and returns the number in the ASCII form for `strconv.ParseFloat` (e.g. `-1234.56`).
+func parseLocaleNumber(d *localeData, text string, lenient bool) (string, bool) {
+	for _, s := range localeSymbols(d) {
+		if v, ok := parseNumberSymbols(d, s, text, lenient); ok {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// parseNumberSymbols parses the number with the symbols.
+func parseNumberSymbols(d *localeData, s *numberSymbols, text string, lenient bool) (string, bool) {
+	var b strings.Builder
+	groups := []string{s.group}
+	if lenient {
+		text = strings.TrimFunc(text, unicode.IsSpace)
+		for _, minus := range []string{s.minus, "-", "−"} {
+			if strings.HasPrefix(text, minus) {
+				text = strings.TrimPrefix(text, minus)
+				b.WriteByte('-')
+				break
+			}
+		}
+		if b.Len() == 0 {
+			text = strings.TrimPrefix(text, "+")
+		}
+		groups = append(groups, " ", nbsp, nnbsp, "'", "’")
+	} else {
+		if strings.HasPrefix(text, s.minus) {
+			text = strings.TrimPrefix(text, s.minus)
+			b.WriteByte('-')
+		}
+		// The ASCII spaces are the same as the no-break spaces that are used as the grouping separators.
+		if s.group == nbsp || s.group == nnbsp {
+			groups = append(groups, " ", nbsp, nnbsp)
+		}
+	}
+
+	integer, fraction, hasFraction := strings.Cut(text, s.decimal)
+	if hasFraction && fraction == "" {
+		return "", false
+	}
+	if integer == "" && (!hasFraction || !lenient) {
+		return "", false
+	}
+	for _, group := range groups[1:] {
+		if group != s.decimal {
+			integer = strings.ReplaceAll(integer, group, s.group)
+		}
+	}
+	chunks := strings.Split(integer, s.group)
+	for j, chunk := range chunks {
+		if chunk == "" && (len(chunks) > 1 || !lenient) {
+			return "", false
+		}
+		digits, ok := asciiDigits(s, chunk, lenient)
+		if !ok {
+			return "", false
+		}
+		if !lenient && len(chunks) > 1 {
+			n := utf8.RuneCountInString(chunk)
+			switch {
+			case j == len(chunks)-1 && n != d.primaryGrouping,
+				j > 0 && j < len(chunks)-1 && n != d.secondaryGrouping,
+				j == 0 && n > d.secondaryGrouping:
+				return "", false
+			}
+		}
+		b.WriteString(digits)
+	}
+	if integer == "" {
+		b.WriteByte('0')
+	}
+	if hasFraction {
+		digits, ok := asciiDigits(s, fraction, lenient)
+		if !ok {
+			return "", false
+		}
+		b.WriteByte('.')
+		b.WriteString(digits)
+	}
+	return b.String(), true
+}
+
+// asciiDigits converts the digits of the numbering system to the ASCII digits,
+// the ASCII digits are always accepted if it's lenient.
+func asciiDigits(s *numberSymbols, text string, lenient bool) (string, bool) {
+	var b strings.Builder
+	for _, r := range text {
+		switch j := strings.IndexRune(s.digits, r); {
+		case j != -1:
+			b.WriteByte('0' + byte(utf8.RuneCountInString(s.digits[:j])))
+		case lenient && r >= '0' && r <= '9':
+			b.WriteRune(r)
+		default:
+			return "", false
+		}
+	}
+	return b.String(), true
+}
+
+// ParseDate parses a date that was written in the style of the locale (e.g. `2026/10/16` in Taiwan).
+//
+// If `WithLenient` was used, the separators and the order of the fields might differ from the style,
+// the months can be numbers or the names, the weekdays are ignored and the 2-digit years are expanded.
+func (l *Locale) ParseDate(text string, style DateStyle, options ...func(*ParseFormat)) (time.Time, error) {
+	f := newParseFormat(options...)
+	d := lookupLocale(dateDatas, l.locale)
+	var (
+		t  time.Time
+		ok bool
+	)
+	if f.lenient {
+		t, ok = d.parseLenient(d.dates[style], text, f.location)
+	} else {
+		t, ok = d.parse(d.dates[style], text, f.location)
+	}
+	if !ok {
+		return time.Time{}, l.parseError("date", text, d.format(d.dates[style], time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)))
+	}
+	return t, nil
+}
+
+// parse parses the text with a CLDR date pattern like `MMM d, y` strictly.
+func (d *dateData) parse(pattern, text string, loc *time.Location) (time.Time, bool) {
+	year, month, day, weekday := -1, -1, -1, -1
+	for j := 0; j < len(pattern); {
+		c := pattern[j]
+		switch {
+		case c == '\'':
+			literal := "'"
+			end := strings.IndexByte(pattern[j+1:], '\'')
+			switch {
+			case end == 0:
+				j += 2
+			case end == -1:
+				literal, j = pattern[j+1:], len(pattern)
+			default:
+				literal, j = pattern[j+1:j+1+end], j+end+2
+			}
+			if !strings.HasPrefix(text, literal) {
+				return time.Time{}, false
+			}
+			text = text[len(literal):]
+		case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+			k := j
+			for k < len(pattern) && pattern[k] == c {
+				k++
+			}
+			n := k - j
+			j = k
+
+			var ok bool
+			switch {
+			case c == 'y':
+				year, text, ok = parseDigits(text, n == 2, 2)
+				if ok && n == 2 {
+					year = expandYear(year)
+				}
+			case (c == 'M' || c == 'L') && n <= 2:
+				month, text, ok = parseDigits(text, n == 2, 2)
+			case c == 'M' || c == 'L':
+				names := d.monthsShort
+				if n == 4 {
+					names = d.months
+				}
+				month, text, ok = parseName(names[:], text, false)
+				month++
+			case c == 'd':
+				day, text, ok = parseDigits(text, n == 2, 2)
+			case c == 'E' || c == 'c' || c == 'e':
+				names := d.weekdaysShort
+				if n == 4 {
+					names = d.weekdays
+				}
+				weekday, text, ok = parseName(names[:], text, false)
+			}
+			if !ok {
+				return time.Time{}, false
+			}
+		default:
+			_, size := utf8.DecodeRuneInString(pattern[j:])
+			if !strings.HasPrefix(text, pattern[j:j+size]) {
+				return time.Time{}, false
+			}
+			text = text[size:]
+			j += size
+		}
+	}
+	if text != "" {
+		return time.Time{}, false
+	}
+	t, ok := validDate(year, month, day, loc)
+	if ok && weekday != -1 && int(t.Weekday()) != weekday {
+		return time.Time{}, false
+	}
+	return t, ok
+}
+
+// parseLenient parses the text with the fields of a CLDR date pattern, the numbers are assigned to the fields in the order of the pattern,
+// and the names of the months are recognized wherever they are.
+func (d *dateData) parseLenient(pattern, text string, loc *time.Location) (time.Time, bool) {
+	var (
+		fields  []byte
+		numbers []string
+		month   = -1
+	)
+	for j := 0; j < len(pattern); j++ {
+		switch c := pattern[j]; c {
+		case '\'':
+			if end := strings.IndexByte(pattern[j+1:], '\''); end != -1 {
+				j += end + 1
+			}
+		case 'y', 'd', 'M', 'L':
+			if c == 'L' {
+				c = 'M'
+			}
+			if len(fields) == 0 || fields[len(fields)-1] != c {
+				fields = append(fields, c)
+			}
+		}
+	}
+	for len(text) > 0 {
+		r, size := utf8.DecodeRuneInString(text)
+		switch {
+		case r >= '0' && r <= '9':
+			end := strings.IndexFunc(text, func(r rune) bool { return r < '0' || r > '9' })
+			if end == -1 {
+				end = len(text)
+			}
+			numbers = append(numbers, text[:end])
+			text = text[end:]
+		case unicode.IsLetter(r):
+			end := strings.IndexFunc(text, func(r rune) bool { return !unicode.IsLetter(r) && r != '.' })
+			if end == -1 {
+				end = len(text)
+			}
+			word := strings.TrimSuffix(text[:end], ".")
+			if v, _, ok := parseName(d.months[:], word, true); ok && len(word) > 0 {
+				month = v + 1
+			} else if v, _, ok := parseName(d.monthsShort[:], word, true); ok {
+				month = v + 1
+			}
+			text = text[end:]
+		default:
+			text = text[size:]
+		}
+	}
+
+	year, day := -1, -1
+	for _, field := range fields {
+		if field == 'M' && month != -1 {
+			continue
+		}
+		if len(numbers) == 0 {
+			return time.Time{}, false
+		}
+		v, err := strconv.Atoi(numbers[0])
+		if err != nil {
+			return time.Time{}, false
+		}
+		switch field {
+		case 'y':
+			year = v
+			if len(numbers[0]) <= 2 {
+				year = expandYear(v)
+			}
+		case 'M':
+			month = v
+		case 'd':
+			day = v
+		}
+		numbers = numbers[1:]
+	}
+	if len(numbers) != 0 {
+		return time.Time{}, false
+	}
+	return validDate(year, month, day, loc)
+}
+
+// parseDigits parses the leading digits of the text, exactly `n` digits are required if it's fixed.
+func parseDigits(text string, fixed bool, n int) (int, string, bool) {
+	end := strings.IndexFunc(text, func(r rune) bool { return r < '0' || r > '9' })
+	if end == -1 {
+		end = len(text)
+	}
+	if end == 0 || (fixed && end != n) {
+		return 0, text, false
+	}
+	v, err := strconv.Atoi(text[:end])
+	return v, text[end:], err == nil
+}
+
+// parseName finds the longest name that the text starts with, the whole text has to be the name if it's a word.
+func parseName(names []string, text string, word bool) (int, string, bool) {
+	index, length := -1, 0
+	for j, name := range names {
+		switch {
+		case word && strings.EqualFold(strings.TrimSuffix(name, "."), text):
+			return j, "", true
+		case !word && strings.HasPrefix(text, name) && len(name) > length:
+			index, length = j, len(name)
+		}
+	}
+	return index, text[length:], index != -1
+}
+
+// expandYear expands a 2-digit year to the century that it's in 80 years before or 20 years after now.
+func expandYear(year int) int {
+	now := time.Now().Year()
+	year += now / 100 * 100
+	switch {
+	case year >= now+20:
+		year -= 100
+	case year < now-80:
+		year += 100
+	}
+	return year
+}
+
+// validDate creates the date if the fields are in range.
+func validDate(year, month, day int, loc *time.Location) (time.Time, bool) {
+	if year < 0 || month < 1 || month > 12 || day < 1 {
+		return time.Time{}, false
+	}
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+	if t.Day() != day {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// parseMessages is the localized error messages of the parsers, `{0}` is the input and `{1}` is the expected format.
+var parseMessages = map[string]map[string]string{
+	"en": {
+		"number":   "\"{0}\" is not a valid number, expected a number like \"{1}\"",
+		"percent":  "\"{0}\" is not a valid percentage, expected a percentage like \"{1}\"",
+		"currency": "\"{0}\" is not a valid amount, expected an amount like \"{1}\"",
+		"date":     "\"{0}\" is not a valid date, expected a date like \"{1}\"",
+	},
+	"zh": {
+		"number":   "“{0}”不是有效的数字，格式应为“{1}”",
+		"percent":  "“{0}”不是有效的百分比，格式应为“{1}”",
+		"currency": "“{0}”不是有效的金额，格式应为“{1}”",
+		"date":     "“{0}”不是有效的日期，格式应为“{1}”",
+	},
+	"zh-tw": {
+		"number":   "「{0}」不是有效的數字，格式應為「{1}」",
+		"percent":  "「{0}」不是有效的百分比，格式應為「{1}」",
+		"currency": "「{0}」不是有效的金額，格式應為「{1}」",
+		"date":     "「{0}」不是有效的日期，格式應為「{1}」",
+	},
+	"ja": {
+		"number":   "「{0}」は有効な数値ではありません。「{1}」のように入力してください",
+		"percent":  "「{0}」は有効なパーセンテージではありません。「{1}」のように入力してください",
+		"currency": "「{0}」は有効な金額ではありません。「{1}」のように入力してください",
+		"date":     "「{0}」は有効な日付ではありません。「{1}」のように入力してください",
+	},
+	"ko": {
+		"number":   "\"{0}\"은(는) 올바른 숫자가 아닙니다. \"{1}\" 형식으로 입력하세요",
+		"percent":  "\"{0}\"은(는) 올바른 백분율이 아닙니다. \"{1}\" 형식으로 입력하세요",
+		"currency": "\"{0}\"은(는) 올바른 금액이 아닙니다. \"{1}\" 형식으로 입력하세요",
+		"date":     "\"{0}\"은(는) 올바른 날짜가 아닙니다. \"{1}\" 형식으로 입력하세요",
+	},
+	"de": {
+		"number":   "„{0}“ ist keine gültige Zahl, erwartet wird eine Zahl wie „{1}“",
+		"percent":  "„{0}“ ist kein gültiger Prozentsatz, erwartet wird ein Prozentsatz wie „{1}“",
+		"currency": "„{0}“ ist kein gültiger Betrag, erwartet wird ein Betrag wie „{1}“",
+		"date":     "„{0}“ ist kein gültiges Datum, erwartet wird ein Datum wie „{1}“",
+	},
+	"fr": {
+		"number":   "«" + nbsp + "{0}" + nbsp + "» n’est pas un nombre valide, un nombre comme «" + nbsp + "{1}" + nbsp + "» est attendu",
+		"percent":  "«" + nbsp + "{0}" + nbsp + "» n’est pas un pourcentage valide, un pourcentage comme «" + nbsp + "{1}" + nbsp + "» est attendu",
+		"currency": "«" + nbsp + "{0}" + nbsp + "» n’est pas un montant valide, un montant comme «" + nbsp + "{1}" + nbsp + "» est attendu",
+		"date":     "«" + nbsp + "{0}" + nbsp + "» n’est pas une date valide, une date comme «" + nbsp + "{1}" + nbsp + "» est attendue",
+	},
+	"es": {
+		"number":   "«{0}» no es un número válido, se espera un número como «{1}»",
+		"percent":  "«{0}» no es un porcentaje válido, se espera un porcentaje como «{1}»",
+		"currency": "«{0}» no es un importe válido, se espera un importe como «{1}»",
+		"date":     "«{0}» no es una fecha válida, se espera una fecha como «{1}»",
+	},
+}
+
+func init() {
+	parseMessages["zh-hk"] = parseMessages["zh-tw"]
+}
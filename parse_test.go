@@ -0,0 +1,254 @@
+package i18n
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNumber(t *testing.T) {
+	assert := assert.New(t)
+
+	en := newFormatLocale("en-us")
+	de := newFormatLocale("de-de")
+	fr := newFormatLocale("fr-fr")
+	hi := newFormatLocale("en-in")
+	ar := newFormatLocale("ar-eg")
+
+	for _, v := range []struct {
+		locale *Locale
+		text   string
+		want   float64
+	}{
+		{en, "1,234.56", 1234.56},
+		{en, "1234.56", 1234.56},
+		{en, "-1,234,567", -1234567},
+		{de, "1.234,56", 1234.56},
+		{fr, "1\u202f234,56", 1234.56},
+		{fr, "1 234,5", 1234.5},
+		{fr, "1\u00a0234,5", 1234.5},
+		{hi, "12,34,567", 1234567},
+		{ar, "١٬٢٣٤٫٥", 1234.5},
+	} {
+		n, err := v.locale.ParseNumber(v.text)
+		assert.NoError(err, v.text)
+		assert.Equal(v.want, n, v.text)
+	}
+
+	// Strict.
+	for _, v := range []struct {
+		locale *Locale
+		text   string
+	}{
+		{en, "1,23,456"},
+		{en, "1,234."},
+		{en, " 1,234"},
+		{en, "1.234,56"},
+		{de, "1,234.56"},
+		{en, "12a"},
+		{en, ""},
+		{hi, "1,234,567"},
+		{de, "1 234,56"},
+	} {
+		_, err := v.locale.ParseNumber(v.text)
+		assert.Error(err, v.text)
+	}
+
+	// Lenient.
+	for _, v := range []struct {
+		locale *Locale
+		text   string
+		want   float64
+	}{
+		{en, " 1,23,456 ", 123456},
+		{en, "+12", 12},
+		{en, ".5", 0.5},
+		{de, "1 234,5", 1234.5},
+		{fr, "1 234,56", 1234.56},
+		{ar, "١٬٢٣4", 1234},
+	} {
+		n, err := v.locale.ParseNumber(v.text, WithLenient())
+		assert.NoError(err, v.text)
+		assert.Equal(v.want, n, v.text)
+	}
+	_, err := de.ParseNumber("1,234.56", WithLenient())
+	assert.Error(err)
+}
+
+func TestParsePercent(t *testing.T) {
+	assert := assert.New(t)
+
+	en := newFormatLocale("en-us")
+	de := newFormatLocale("de-de")
+
+	v, err := en.ParsePercent("12.5%")
+	assert.NoError(err)
+	assert.Equal(0.125, v)
+	v, err = de.ParsePercent("12,5\u00a0%")
+	assert.NoError(err)
+	assert.Equal(0.125, v)
+	v, err = de.ParsePercent("12,5 %")
+	assert.NoError(err)
+	assert.Equal(0.125, v)
+	_, err = de.ParsePercent("12,5%")
+	assert.Error(err)
+	_, err = en.ParsePercent("12")
+	assert.Error(err)
+
+	v, err = de.ParsePercent("12,5 %", WithLenient())
+	assert.NoError(err)
+	assert.Equal(0.125, v)
+	v, err = en.ParsePercent("12", WithLenient())
+	assert.NoError(err)
+	assert.Equal(0.12, v)
+}
+
+func TestParseCurrency(t *testing.T) {
+	assert := assert.New(t)
+
+	en := newFormatLocale("en-us")
+	de := newFormatLocale("de-de")
+	tw := newFormatLocale("zh-tw")
+
+	for _, v := range []struct {
+		locale   *Locale
+		text     string
+		currency string
+		want     float64
+	}{
+		{en, "$1,200.00", "USD", 1200},
+		{en, "-$1,200.50", "USD", -1200.5},
+		{en, "($1,200.00)", "USD", -1200},
+		{en, "NT$1,200", "TWD", 1200},
+		{en, "TWD\u00a01,200", "TWD", 1200},
+		{tw, "$1,200.00", "TWD", 1200},
+		{de, "1.234,57\u00a0€", "EUR", 1234.57},
+		{de, "-1.234,57\u00a0€", "EUR", -1234.57},
+		{de, "1.234,56 €", "EUR", 1234.56},
+	} {
+		n, err := v.locale.ParseCurrency(v.text, v.currency)
+		assert.NoError(err, v.text)
+		assert.Equal(v.want, n, v.text)
+	}
+	for _, text := range []string{"1,200.00", "€1,200.00", "$ 1,200.00", "$-1,200.00"} {
+		_, err := en.ParseCurrency(text, "USD")
+		assert.Error(err, text)
+	}
+
+	// Lenient.
+	for _, v := range []struct {
+		locale *Locale
+		text   string
+		want   float64
+	}{
+		{en, "1,200.00", 1200},
+		{en, " $ 1,200 ", 1200},
+		{en, "1200 usd", 1200},
+		{en, "(1,200)", -1200},
+		{de, "1.234,57 €", 1234.57},
+	} {
+		currency := "USD"
+		if v.locale == de {
+			currency = "EUR"
+		}
+		n, err := v.locale.ParseCurrency(v.text, currency, WithLenient())
+		assert.NoError(err, v.text)
+		assert.Equal(v.want, n, v.text)
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	assert := assert.New(t)
+
+	en := newFormatLocale("en-us")
+	tw := newFormatLocale("zh-tw")
+	de := newFormatLocale("de-de")
+	fr := newFormatLocale("fr-fr")
+	es := newFormatLocale("es-es")
+	want := time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC)
+
+	for _, v := range []struct {
+		locale *Locale
+		text   string
+		style  DateStyle
+	}{
+		{en, "10/16/26", DateShort},
+		{en, "Oct 16, 2026", DateMedium},
+		{en, "October 16, 2026", DateLong},
+		{en, "Friday, October 16, 2026", DateFull},
+		{tw, "2026/10/16", DateShort},
+		{tw, "2026年10月16日 星期五", DateFull},
+		{de, "16.10.2026", DateMedium},
+		{fr, "16 oct. 2026", DateMedium},
+		{es, "16 de octubre de 2026", DateLong},
+	} {
+		d, err := v.locale.ParseDate(v.text, v.style)
+		assert.NoError(err, v.text)
+		assert.Equal(want, d, v.text)
+	}
+	for _, v := range []struct {
+		locale *Locale
+		text   string
+		style  DateStyle
+	}{
+		{en, "10/16/2026", DateShort},
+		{en, "Thursday, October 16, 2026", DateFull},
+		{tw, "2026-10-16", DateShort},
+		{tw, "2026/02/30", DateShort},
+		{de, "16.10.2026 ", DateMedium},
+		{en, "Foo 16, 2026", DateMedium},
+	} {
+		_, err := v.locale.ParseDate(v.text, v.style)
+		assert.Error(err, v.text)
+	}
+
+	// Lenient.
+	for _, v := range []struct {
+		locale *Locale
+		text   string
+		style  DateStyle
+	}{
+		{en, "10/16/2026", DateShort},
+		{en, "10-16-26", DateShort},
+		{en, "oct 16 2026", DateMedium},
+		{en, "16 October 2026", DateMedium},
+		{en, "Thu, October 16, 2026", DateFull},
+		{tw, "2026-10-16", DateShort},
+		{tw, "2026年10月16日", DateShort},
+		{de, "16.10.26", DateMedium},
+		{fr, "16/10/2026", DateLong},
+	} {
+		d, err := v.locale.ParseDate(v.text, v.style, WithLenient())
+		assert.NoError(err, v.text)
+		assert.Equal(want, d, v.text)
+	}
+	_, err := en.ParseDate("10/16", DateShort, WithLenient())
+	assert.Error(err)
+
+	// Location.
+	taipei := time.FixedZone("CST", 8*60*60)
+	d, err := tw.ParseDate("2026/10/16", DateShort, WithLocation(taipei))
+	assert.NoError(err)
+	assert.Equal(time.Date(2026, time.October, 16, 0, 0, 0, 0, taipei), d)
+}
+
+func TestParseError(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := newFormatLocale("en-us").ParseNumber("1.234,56")
+	assert.EqualError(err, "\"1.234,56\" is not a valid number, expected a number like \"1,234.56\"")
+
+	var parseErr *ParseError
+	assert.True(errors.As(err, &parseErr))
+	assert.Equal("1.234,56", parseErr.Input)
+	assert.Equal("1,234.56", parseErr.Expected)
+
+	_, err = newFormatLocale("zh-tw").ParseDate("2026-10-16", DateShort)
+	assert.EqualError(err, "「2026-10-16」不是有效的日期，格式應為「2006/1/2」")
+	_, err = newFormatLocale("de-de").ParseCurrency("€12", "EUR")
+	assert.EqualError(err, "„€12“ ist kein gültiger Betrag, erwartet wird ein Betrag wie „1.234,56\u00a0€“")
+	_, err = newFormatLocale("en-us").ParsePercent("abc")
+	assert.EqualError(err, "\"abc\" is not a valid percentage, expected a percentage like \"12.5%\"")
+}
@@ -0,0 +1,94 @@
+package i18n
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// pathPattern extracts the locale and the namespace from the paths of the translation files.
+type pathPattern struct {
+	pattern string
+	regexp  *regexp.Regexp
+}
+
+// WithPathPattern changes how the locale and the namespace are determined from the paths of the translation files
+// for `LoadFiles`, `LoadGlob` and `LoadFS`. `{locale}` and `{namespace}` match a directory or a part of the filename,
+// and `*` matches anything but `/`, such as `{locale}/{namespace}.json` for `locales/zh-TW/common.json`.
+// The patterns match the end of the paths and the first matched pattern is used, the translations are named
+// like `common.message` if there is a namespace.
+func WithPathPattern(patterns ...string) func(*I18n) {
+	return func(i *I18n) {
+		for _, v := range patterns {
+			i.pathPatterns = append(i.pathPatterns, compilePathPattern(v))
+		}
+	}
+}
+
+// compilePathPattern
+func compilePathPattern(pattern string) *pathPattern {
+	var b strings.Builder
+	b.WriteString(`(?:^|/)`)
+	for v := pattern; v != ""; {
+		switch {
+		case strings.HasPrefix(v, "{locale}"):
+			b.WriteString(`(?P<locale>[^/]+?)`)
+			v = v[len("{locale}"):]
+		case strings.HasPrefix(v, "{namespace}"):
+			b.WriteString(`(?P<namespace>[^/]+?)`)
+			v = v[len("{namespace}"):]
+		case v[0] == '*':
+			b.WriteString(`[^/]*`)
+			v = v[1:]
+		default:
+			b.WriteString(regexp.QuoteMeta(v[:1]))
+			v = v[1:]
+		}
+	}
+	b.WriteString(`$`)
+	return &pathPattern{
+		pattern: pattern,
+		regexp:  regexp.MustCompile(b.String()),
+	}
+}
+
+// match returns the locale and the namespace of the path.
+func (p *pathPattern) match(path string) (locale, namespace string, ok bool) {
+	m := p.regexp.FindStringSubmatch(path)
+	if m == nil {
+		return "", "", false
+	}
+	if j := p.regexp.SubexpIndex("locale"); j != -1 {
+		locale = m[j]
+	}
+	if j := p.regexp.SubexpIndex("namespace"); j != -1 {
+		namespace = m[j]
+	}
+	return locale, namespace, locale != ""
+}
+
+// fileLocale returns the locale and the prefix of the names of the translation file,
+// an error is returned if the path doesn't match any of the path patterns.
+func (i *I18n) fileLocale(path string) (locale, prefix string, err error) {
+	if len(i.pathPatterns) == 0 {
+		return nameInsenstive(path), i.namespace(path), nil
+	}
+	path = filepath.ToSlash(path)
+	for _, p := range i.pathPatterns {
+		locale, namespace, ok := p.match(path)
+		if !ok {
+			continue
+		}
+		locale = strings.ReplaceAll(strings.ToLower(locale), "_", "-")
+		if namespace != "" {
+			return locale, namespace + ".", nil
+		}
+		return locale, "", nil
+	}
+	patterns := make([]string, len(i.pathPatterns))
+	for j, p := range i.pathPatterns {
+		patterns[j] = p.pattern
+	}
+	return "", "", fmt.Errorf("the locale of %q can't be determined by the path patterns %q", path, patterns)
+}
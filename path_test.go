@@ -0,0 +1,57 @@
+package i18n
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPathPattern(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("zh-tw", WithPathPattern("{locale}/{namespace}.json"))
+	assert.NoError(i.LoadGlob("test/locales/*/*.json"))
+
+	tw := i.NewLocale("zh-TW")
+	assert.Equal("zh-tw", tw.Locale())
+	assert.Equal("首頁", tw.String("common.title"))
+	assert.Equal("找不到頁面", tw.Scope("errors").String("not_found"))
+	assert.Equal("ホーム", i.NewLocale("ja").String("common.title"))
+
+	// Without the namespace.
+	i = New("zh-tw", WithPathPattern("{locale}/*.json"))
+	assert.NoError(i.LoadFiles("test/locales/zh-TW/common.json", "test/locales/zh-TW/errors.json"))
+	assert.Equal("首頁", i.NewLocale("zh-tw").String("title"))
+	assert.Equal("找不到頁面", i.NewLocale("zh-tw").String("not_found"))
+
+	// Unknown locale.
+	i = New("zh-tw", WithPathPattern("locales/{locale}/{namespace}.json"))
+	assert.EqualError(i.LoadGlob("test/*.json"), `the locale of "test/zh-tw.json" can't be determined by the path patterns ["locales/{locale}/{namespace}.json"]`)
+}
+
+func TestPathPatternFS(t *testing.T) {
+	assert := assert.New(t)
+
+	fsys := fstest.MapFS{
+		"locales/zh_TW/common.json":    {Data: []byte(`{"title": "首頁"}`)},
+		"locales/ja/errors.json":       {Data: []byte(`{"not_found": "ページが見つかりません"}`)},
+		"locales/en-us.json":           {Data: []byte(`{"title": "Home"}`)},
+		"locales/fr/messages.fr.json":  {Data: []byte(`{"title": "Accueil"}`)},
+		"locales/de/nested/admin.json": {Data: []byte(`{"title": "Verwaltung"}`)},
+	}
+	i := New("en-us", WithPathPattern("locales/{locale}.json", "{locale}/{namespace}.json"))
+	assert.NoError(i.LoadFS(fsys, "locales/*/*.json", "locales/*.json"))
+
+	assert.Equal("首頁", i.NewLocale("zh-tw").String("common.title"))
+	assert.Equal("ページが見つかりません", i.NewLocale("ja").String("errors.not_found"))
+	assert.Equal("Home", i.NewLocale("en-us").String("title"))
+	assert.Equal("Accueil", i.NewLocale("fr").String("messages.fr.title"))
+
+	// The patterns match the whole directories.
+	i = New("en-us", WithPathPattern("locales/{locale}/{namespace}.json"))
+	assert.Error(i.LoadFS(fsys, "locales/*/*/*.json"))
+	i = New("en-us", WithPathPattern("locales/{locale}/*/{namespace}.json"))
+	assert.NoError(i.LoadFS(fsys, "locales/*/*/*.json"))
+	assert.Equal("Verwaltung", i.NewLocale("de").String("admin.title"))
+}
@@ -0,0 +1,240 @@
+package i18n
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Placeholder is the syntax of the placeholders in the translations that are not templates.
+type Placeholder int
+
+const (
+	// PlaceholderTemplate uses the templates only (e.g. `{{ .Name }}`), it's the default.
+	PlaceholderTemplate Placeholder = iota
+	// PlaceholderBrace replaces the named placeholders (e.g. `{Name}`) with the fields of the data.
+	PlaceholderBrace
+	// PlaceholderPrintf replaces the printf-style placeholders (e.g. `%s`, `%[1]d`) with the data in order.
+	PlaceholderPrintf
+)
+
+// WithPlaceholder changes the placeholder syntax, the translations that contain `{{` are still rendered as the templates.
+func WithPlaceholder(p Placeholder) func(*I18n) {
+	return func(i *I18n) {
+		i.placeholder = p
+	}
+}
+
+// placeholder is a part of the compiled text, it's a literal text if both the `name` and the `verb` are empty.
+type placeholder struct {
+	text  string
+	name  string
+	verb  string
+	index int
+}
+
+// compilePlaceholders splits the text into the literal texts and the placeholders,
+// nil is returned if the text has nothing to be replaced so it can be output directly.
+func (i *I18n) compilePlaceholders(text string) []placeholder {
+	switch i.placeholder {
+	case PlaceholderBrace:
+		return compileBraces(text)
+	case PlaceholderPrintf:
+		return compilePrintf(text)
+	}
+	return nil
+}
+
+// compileBraces compiles `{Name}`, the braces that don't wrap a name (e.g. `{ }`) are literal texts.
+func compileBraces(text string) []placeholder {
+	var (
+		parts   []placeholder
+		literal strings.Builder
+		found   bool
+	)
+	for len(text) > 0 {
+		start := strings.IndexByte(text, '{')
+		if start == -1 {
+			break
+		}
+		end := strings.IndexByte(text[start:], '}')
+		if end == -1 {
+			break
+		}
+		name := text[start+1 : start+end]
+		if !isPlaceholderName(name) {
+			literal.WriteString(text[:start+1])
+			text = text[start+1:]
+			continue
+		}
+		literal.WriteString(text[:start])
+		if literal.Len() > 0 {
+			parts = append(parts, placeholder{text: literal.String()})
+			literal.Reset()
+		}
+		parts = append(parts, placeholder{name: name})
+		text = text[start+end+1:]
+		found = true
+	}
+	if !found {
+		return nil
+	}
+	literal.WriteString(text)
+	if literal.Len() > 0 {
+		parts = append(parts, placeholder{text: literal.String()})
+	}
+	return parts
+}
+
+// isPlaceholderName
+func isPlaceholderName(v string) bool {
+	if v == "" {
+		return false
+	}
+	for j, r := range v {
+		if r != '_' && !(r >= 'a' && r <= 'z') && !(r >= 'A' && r <= 'Z') && !(j > 0 && r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// compilePrintf compiles the printf verbs like `fmt.Sprintf` does, `%[1]d` chooses the argument explicitly
+// and the following verbs continue from the next argument, `%%` is a literal percent sign.
+func compilePrintf(text string) []placeholder {
+	var (
+		parts   []placeholder
+		literal strings.Builder
+		found   bool
+		next    int
+	)
+	for len(text) > 0 {
+		start := strings.IndexByte(text, '%')
+		if start == -1 {
+			break
+		}
+		literal.WriteString(text[:start])
+		text = text[start+1:]
+		if strings.HasPrefix(text, "%") {
+			literal.WriteByte('%')
+			text = text[1:]
+			found = true
+			continue
+		}
+		j := 0
+		for j < len(text) && strings.IndexByte("+-# 0", text[j]) != -1 {
+			j++
+		}
+		index := next
+		if j < len(text) && text[j] == '[' {
+			end := strings.IndexByte(text[j:], ']')
+			if end == -1 {
+				literal.WriteByte('%')
+				continue
+			}
+			n, err := strconv.Atoi(text[j+1 : j+end])
+			if err != nil || n < 1 {
+				literal.WriteByte('%')
+				continue
+			}
+			index = n - 1
+			text = text[:j] + text[j+end+1:]
+		}
+		for j < len(text) && (text[j] == '.' || (text[j] >= '0' && text[j] <= '9')) {
+			j++
+		}
+		if j == len(text) || !isVerb(text[j]) {
+			literal.WriteByte('%')
+			continue
+		}
+		if literal.Len() > 0 {
+			parts = append(parts, placeholder{text: literal.String()})
+			literal.Reset()
+		}
+		parts = append(parts, placeholder{
+			verb:  "%" + text[:j+1],
+			index: index,
+		})
+		text = text[j+1:]
+		next = index + 1
+		found = true
+	}
+	if !found {
+		return nil
+	}
+	literal.WriteString(text)
+	if literal.Len() > 0 {
+		parts = append(parts, placeholder{text: literal.String()})
+	}
+	return parts
+}
+
+// isVerb
+func isVerb(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// writePlaceholders renders the compiled placeholders with the data, the missing names are output as-is.
+func writePlaceholders(b *bytes.Buffer, parts []placeholder, data []any) {
+	var (
+		merged any
+		ok     bool
+	)
+	for _, p := range parts {
+		switch {
+		case p.name != "":
+			if !ok {
+				merged, ok = mergeData(data...), true
+			}
+			if v, found := lookupField(merged, p.name); found {
+				fmt.Fprint(b, v)
+			} else {
+				b.WriteString("{" + p.name + "}")
+			}
+		case p.verb != "":
+			if p.index < len(data) {
+				fmt.Fprintf(b, p.verb, data[p.index])
+			} else {
+				b.WriteString("%!" + p.verb[len(p.verb)-1:] + "(MISSING)")
+			}
+		default:
+			b.WriteString(p.text)
+		}
+	}
+}
+
+// lookupField returns the value of the key of a map or the field of a struct.
+func lookupField(data any, name string) (any, bool) {
+	if m, ok := data.(map[string]any); ok {
+		v, ok := m[name]
+		return v, ok
+	}
+	rv := reflect.ValueOf(data)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil, false
+		}
+		v := rv.MapIndex(reflect.ValueOf(name).Convert(rv.Type().Key()))
+		if !v.IsValid() {
+			return nil, false
+		}
+		return v.Interface(), true
+	case reflect.Struct:
+		f, ok := rv.Type().FieldByName(name)
+		if !ok || !f.IsExported() {
+			return nil, false
+		}
+		v, err := rv.FieldByIndexErr(f.Index)
+		if err != nil {
+			return nil, false
+		}
+		return v.Interface(), true
+	}
+	return nil, false
+}
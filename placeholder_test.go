@@ -0,0 +1,82 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlaceholderBrace(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us", WithPlaceholder(PlaceholderBrace))
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"test_hello":    "Hello, {Name}!",
+			"test_profile":  "{Name} <{Email}> ({Role})",
+			"test_apples":   "No apples | 1 apple | {Count} apples",
+			"test_views":    "{FormattedCount} views",
+			"test_literal":  "Use { } or {1} or {Name as is",
+			"test_template": "Hello, {{ upper .Name }}!",
+		},
+	})
+	l := i.NewLocale("en-us")
+
+	assert.Equal("Hello, Yami!", l.String("test_hello", map[string]any{"Name": "Yami"}))
+	assert.Equal("Hello, Yami!", l.String("test_hello", testUser{Name: "Yami"}))
+	assert.Equal("Hello, Yami!", l.String("test_hello", map[string]string{"Name": "Yami"}))
+	assert.Equal("Hello, {Name}!", l.String("test_hello"))
+	assert.Equal("Yami <yami@example.com> (Admin)", l.String("test_profile", testUser{Name: "Yami", Email: "yami@example.com"}, map[string]any{"Role": "Admin"}))
+	assert.Equal("3 apples", l.Number("test_apples", 3))
+	assert.Equal("1,234 views", l.Number("test_views", 1234))
+	assert.Equal("Use { } or {1} or {Name as is", l.String("test_literal"))
+	assert.Equal("Hello, YAMI!", l.String("test_template", map[string]any{"Name": "Yami"}))
+
+	// Runtime translations.
+	assert.Equal("Bye, Yami!", l.String("Bye, {Name}!", map[string]any{"Name": "Yami"}))
+	assert.Nil(i.compiled.Load().translations["en-us"]["test_literal"].texts[0].placeholders)
+}
+
+func TestPlaceholderPrintf(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us", WithPlaceholder(PlaceholderPrintf))
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"test_hello":   "Hello, %s!",
+			"test_order":   "%[2]s ordered %[1]d items, %[3].2f%% off",
+			"test_apples":  "No apples | 1 apple | %d apples",
+			"test_percent": "100%% sure",
+			"test_padding": "[%5s|%-5s]",
+		},
+		"zh-tw": map[string]string{
+			"test_order": "%[2]s 訂購了 %[1]d 件商品",
+		},
+	})
+	l := i.NewLocale("en-us")
+
+	assert.Equal("Hello, Yami!", l.String("test_hello", "Yami"))
+	assert.Equal("Hello, %!s(MISSING)!", l.String("test_hello"))
+	assert.Equal("Yami ordered 3 items, 12.50% off", l.String("test_order", 3, "Yami", 12.5))
+	assert.Equal("Yami 訂購了 3 件商品", i.NewLocale("zh-tw").String("test_order", 3, "Yami", 12.5))
+	assert.Equal("3 apples", l.Number("test_apples", 3))
+	assert.Equal("1 apple", l.Number("test_apples", 1))
+	assert.Equal("5 apples", l.Number("test_apples", 3, 5))
+	assert.Equal("100% sure", l.String("test_percent"))
+	assert.Equal("[   ab|ab   ]", l.String("test_padding", "ab", "ab"))
+}
+
+func TestCompilePlaceholders(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]placeholder{{text: "Hi, "}, {name: "Name"}, {text: "!"}}, compileBraces("Hi, {Name}!"))
+	assert.Equal([]placeholder{{name: "A"}, {name: "B"}}, compileBraces("{A}{B}"))
+	assert.Nil(compileBraces("{ A }"))
+	assert.Equal([]placeholder{{verb: "%s", index: 0}, {text: " "}, {verb: "%d", index: 1}}, compilePrintf("%s %d"))
+	assert.Equal([]placeholder{{verb: "%s", index: 1}, {text: " "}, {verb: "%d", index: 2}}, compilePrintf("%[2]s %d"))
+	assert.Equal([]placeholder{{text: "%"}, {verb: "%-3.1f", index: 0}}, compilePrintf("%%%-3.1f"))
+	assert.Equal([]placeholder{{text: "100%"}}, compilePrintf("100%%"))
+	assert.Nil(compilePrintf("100%"))
+	assert.Nil(compilePrintf("100%!"))
+	assert.Nil(compilePrintf("%[0]d %[x]d"))
+}
@@ -0,0 +1,182 @@
+package i18n
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// pseudoAccents maps the ASCII letters to the accented look-alikes.
+var pseudoAccents = map[rune]rune{
+	'a': 'á', 'b': 'ƀ', 'c': 'ç', 'd': 'ð', 'e': 'é', 'f': 'ƒ', 'g': 'ĝ', 'h': 'ĥ', 'i': 'î',
+	'j': 'ĵ', 'k': 'ķ', 'l': 'ļ', 'm': 'ɱ', 'n': 'ñ', 'o': 'ö', 'p': 'þ', 'q': 'ǫ', 'r': 'ŕ',
+	's': 'š', 't': 'ţ', 'u': 'û', 'v': 'ṽ', 'w': 'ŵ', 'x': 'ẋ', 'y': 'ý', 'z': 'ž',
+	'A': 'Å', 'B': 'Ɓ', 'C': 'Ç', 'D': 'Ð', 'E': 'É', 'F': 'Ƒ', 'G': 'Ĝ', 'H': 'Ĥ', 'I': 'Î',
+	'J': 'Ĵ', 'K': 'Ķ', 'L': 'Ļ', 'M': 'Ṁ', 'N': 'Ñ', 'O': 'Ö', 'P': 'Þ', 'Q': 'Ǫ', 'R': 'Ŕ',
+	'S': 'Š', 'T': 'Ţ', 'U': 'Û', 'V': 'Ṽ', 'W': 'Ŵ', 'X': 'Ẋ', 'Y': 'Ý', 'Z': 'Ž',
+}
+
+const (
+	// rightToLeftOverride forces the following text to be displayed from right to left.
+	rightToLeftOverride = "\u202e"
+	// popDirectionalFormatting ends the `rightToLeftOverride`.
+	popDirectionalFormatting = "\u202c"
+)
+
+// placeholderRegExps match the placeholders of the syntaxes, so they're kept as-is in the pseudo locales.
+var placeholderRegExps = map[Placeholder]*regexp.Regexp{
+	PlaceholderBrace:  regexp.MustCompile(`\{[A-Za-z_][A-Za-z0-9_]*\}`),
+	PlaceholderPrintf: regexp.MustCompile(`%(?:%|[+\-# 0]*(?:\[[0-9]+\])?[0-9.]*[A-Za-z])`),
+}
+
+// Pseudolocalizer converts the translations to a pseudo locale.
+type Pseudolocalizer struct {
+	expansion   int
+	open        string
+	close       string
+	mirror      bool
+	placeholder Placeholder
+}
+
+// WithPseudoExpansion pads the texts by the percentage of their length, defaults to `30`.
+func WithPseudoExpansion(percent int) func(*Pseudolocalizer) {
+	return func(p *Pseudolocalizer) {
+		p.expansion = percent
+	}
+}
+
+// WithPseudoBrackets changes the brackets wrapping the texts, defaults to `[` and `]`.
+func WithPseudoBrackets(open, close string) func(*Pseudolocalizer) {
+	return func(p *Pseudolocalizer) {
+		p.open = open
+		p.close = close
+	}
+}
+
+// WithPseudoMirror displays the texts from right to left instead of accenting them, useful for a `ar-xb` locale.
+func WithPseudoMirror() func(*Pseudolocalizer) {
+	return func(p *Pseudolocalizer) {
+		p.mirror = true
+	}
+}
+
+// WithPseudoPlaceholder keeps the placeholders of the syntax (e.g. `{Name}`, `%s`) as-is like the template actions,
+// `LoadPseudo` uses the syntax of `WithPlaceholder` by default.
+func WithPseudoPlaceholder(p Placeholder) func(*Pseudolocalizer) {
+	return func(ps *Pseudolocalizer) {
+		ps.placeholder = p
+	}
+}
+
+// NewPseudolocalizer creates a new pseudolocalizer.
+func NewPseudolocalizer(options ...func(*Pseudolocalizer)) *Pseudolocalizer {
+	p := &Pseudolocalizer{
+		expansion: 30,
+		open:      "[",
+		close:     "]",
+	}
+	for _, o := range options {
+		o(p)
+	}
+	return p
+}
+
+// Text converts a translation text to the pseudo one,
+// the template actions, the placeholders, plural separators and the context suffix are kept as-is.
+func (p *Pseudolocalizer) Text(text string) string {
+	var context string
+	if loc := contextRegExp.FindStringIndex(text); loc != nil {
+		start := loc[0]
+		if strings.HasSuffix(text[:start], " ") {
+			start--
+		}
+		text, context = text[:start], text[start:]
+	}
+	texts := splitForms(text)
+	for j, v := range texts {
+		texts[j] = p.convert(v)
+	}
+	return strings.Join(texts, " | ") + context
+}
+
+// convert
+func (p *Pseudolocalizer) convert(text string) string {
+	var b strings.Builder
+	var length int
+
+	b.WriteString(p.open)
+	for len(text) > 0 {
+		start := strings.Index(text, "{{")
+		if start == -1 {
+			length += p.writeText(&b, text)
+			break
+		}
+		end := strings.Index(text[start:], "}}")
+		if end == -1 {
+			length += p.writeText(&b, text)
+			break
+		}
+		end += start + 2
+		length += p.writeText(&b, text[:start])
+		b.WriteString(text[start:end])
+		text = text[end:]
+	}
+	if padding := (length*p.expansion + 99) / 100; padding > 0 {
+		b.WriteString(strings.Repeat("~", padding))
+	}
+	b.WriteString(p.close)
+	return b.String()
+}
+
+// writeText writes the text that isn't a template action, the placeholders are written as-is.
+func (p *Pseudolocalizer) writeText(b *strings.Builder, text string) int {
+	re, ok := placeholderRegExps[p.placeholder]
+	if !ok {
+		return p.writePlain(b, text)
+	}
+	var length, last int
+	for _, loc := range re.FindAllStringIndex(text, -1) {
+		length += p.writePlain(b, text[last:loc[0]])
+		b.WriteString(text[loc[0]:loc[1]])
+		last = loc[1]
+	}
+	return length + p.writePlain(b, text[last:])
+}
+
+// writePlain
+func (p *Pseudolocalizer) writePlain(b *strings.Builder, text string) int {
+	if text == "" {
+		return 0
+	}
+	if p.mirror {
+		b.WriteString(rightToLeftOverride)
+		b.WriteString(text)
+		b.WriteString(popDirectionalFormatting)
+		return utf8.RuneCountInString(text)
+	}
+	for _, r := range text {
+		if v, ok := pseudoAccents[r]; ok {
+			r = v
+		}
+		b.WriteRune(r)
+	}
+	return utf8.RuneCountInString(text)
+}
+
+// LoadPseudo synthesizes a pseudo locale (e.g. `en-xa`, `ar-xb`) from the translations of the default locale,
+// so the hardcoded strings and the truncations can be spotted without waiting for the real translations.
+func (i *I18n) LoadPseudo(locale string, options ...func(*Pseudolocalizer)) error {
+	p := NewPseudolocalizer(append([]func(*Pseudolocalizer){WithPseudoPlaceholder(i.placeholder)}, options...)...)
+	locale = nameInsenstive(locale)
+
+	i.pseudoPluralizors.Store(locale, i.pluralizor(i.defaultLocale))
+	return i.update(func(c *catalog) error {
+		translations := make(map[string]string)
+		for name, trans := range c.translations[i.defaultLocale] {
+			translations[name] = p.Text(trans.source())
+		}
+		return i.loadMap(c, map[string]map[string]string{
+			locale: translations,
+		})
+	})
+}
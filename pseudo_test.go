@@ -0,0 +1,87 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPseudolocalizer(t *testing.T) {
+	assert := assert.New(t)
+
+	p := NewPseudolocalizer()
+	assert.Equal("[Ĥéļļö, ŵöŕļð!~~~~]", p.Text("Hello, world!"))
+	assert.Equal("[Ĥéļļö, {{ .Name }}~~~]", p.Text("Hello, {{ .Name }}"))
+	assert.Equal("[Ñöñé~~] | [1 Åþþļé~~~] | [{{ .Count }} Åþþļéš~~~]", p.Text("None | 1 Apple | {{ .Count }} Apples"))
+	assert.Equal("[Þöšţ~~] <verb>", p.Text("Post <verb>"))
+
+	p = NewPseudolocalizer(WithPseudoExpansion(0), WithPseudoBrackets("⟦", "⟧"))
+	assert.Equal("⟦Ĥéļļö⟧", p.Text("Hello"))
+
+	p = NewPseudolocalizer(WithPseudoExpansion(0), WithPseudoBrackets("", ""), WithPseudoMirror())
+	assert.Equal("\u202eHi, \u202c{{ .Name }}", p.Text("Hi, {{ .Name }}"))
+
+	// Placeholders.
+	p = NewPseudolocalizer(WithPseudoExpansion(0), WithPseudoPlaceholder(PlaceholderBrace))
+	assert.Equal("[Ĥéļļö, {Name}! {Count} {ñöţ á ñáɱé}]", p.Text("Hello, {Name}! {Count} {not a name}"))
+	p = NewPseudolocalizer(WithPseudoExpansion(0), WithPseudoPlaceholder(PlaceholderPrintf))
+	assert.Equal("[Ĥéļļö, %s! %[2]d %.2f 100%%]", p.Text("Hello, %s! %[2]d %.2f 100%%"))
+}
+
+func TestLoadPseudo(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("zh-tw")
+	i.LoadMap(map[string]map[string]string{
+		"zh-tw": map[string]string{
+			"test_template": "Hello, {{ .Name }}",
+			"test_plural":   "None | One | {{ .Count }} Items",
+		},
+	})
+	assert.NoError(i.LoadPseudo("en_XA"))
+
+	l := i.NewLocale("en-xa")
+	assert.Equal("en-xa", l.Locale())
+	assert.Equal("[Ĥéļļö, Yami~~~]", l.String("test_template", map[string]any{
+		"Name": "Yami",
+	}))
+	assert.Equal("[Öñé~]", l.Number("test_plural", 1))
+	assert.Equal("[3 Îţéɱš~~]", l.Number("test_plural", 3, map[string]any{
+		"Count": 3,
+	}))
+	assert.Equal("not_exists_message", l.String("not_exists_message"))
+
+	// The pseudo locale uses the pluralizor of the default locale without changing the pluralizors.
+	pluralizors := map[string]Pluralizor{
+		"zh-tw": func(number, choices int) int {
+			return choices - 1
+		},
+	}
+	i = New("zh-tw", WithPluralizor(pluralizors))
+	i.LoadMap(map[string]map[string]string{
+		"zh-tw": map[string]string{
+			"test_plural": "None | One | Many",
+		},
+	})
+	assert.NoError(i.LoadPseudo("en-xa", WithPseudoExpansion(0)))
+	assert.Equal("[Ṁáñý]", i.NewLocale("en-xa").Number("test_plural", 1))
+	assert.Len(pluralizors, 1)
+
+	i = New("zh-tw", WithPlaceholder(PlaceholderBrace))
+	i.LoadMap(map[string]map[string]string{
+		"zh-tw": map[string]string{
+			"test_brace": "Hello, {Name}!",
+		},
+	})
+	assert.NoError(i.LoadPseudo("en-xa", WithPseudoExpansion(0)))
+	assert.Equal("[Ĥéļļö, Yami!]", i.NewLocale("en-xa").String("test_brace", map[string]any{"Name": "Yami"}))
+
+	i = New("zh-tw", WithPlaceholder(PlaceholderPrintf))
+	i.LoadMap(map[string]map[string]string{
+		"zh-tw": map[string]string{
+			"test_printf": "Hello, %s! You have %d messages.",
+		},
+	})
+	assert.NoError(i.LoadPseudo("en-xa", WithPseudoExpansion(0)))
+	assert.Equal("[Ĥéļļö, Yami! Ýöû ĥáṽé 3 ɱéššáĝéš.]", i.NewLocale("en-xa").String("test_printf", "Yami", 3))
+}
@@ -0,0 +1,343 @@
+package i18n
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// WithNumericAuto uses the phrases like `yesterday` or `next week` instead of the numbers when there is one.
+func WithNumericAuto() func(*UnitFormat) {
+	return func(f *UnitFormat) {
+		f.auto = true
+	}
+}
+
+// FormatRelativeTime formats the relative time with the unit (`second`, `minute`, `hour`, `day`, `week`, `month` or `year`),
+// a negative value means the past (e.g. `-3, "minute"` is `3 minutes ago`), and a positive value means the future (e.g. `in 2 days`).
+// The value is formatted as a plain number if the unit is unknown.
+func (l *Locale) FormatRelativeTime(value int, unit string, options ...func(*UnitFormat)) string {
+	f := newUnitFormat(options...)
+	d := lookupLocale(relativeDatas, l.locale)
+	if _, ok := d.pattern(unit, f.width); !ok {
+		d = relativeDatas["en"]
+		if _, ok := d.pattern(unit, f.width); !ok {
+			return l.FormatNumber(value)
+		}
+	}
+	if f.auto {
+		if v, ok := d.phrases[unit][value]; ok {
+			return v
+		}
+	}
+	patterns, _ := d.pattern(unit, f.width)
+	if value < 0 {
+		return l.plural(patterns[1], value, l.FormatNumber(-value))
+	}
+	return l.plural(patterns[0], value, l.FormatNumber(value))
+}
+
+// FormatRelative formats the time relative to `now` with the most suitable unit (e.g. `3 minutes ago`, `in 2 days`).
+func (l *Locale) FormatRelative(t, now time.Time, options ...func(*UnitFormat)) string {
+	value, unit := relativeUnit(t.Sub(now))
+	return l.FormatRelativeTime(value, unit, options...)
+}
+
+// relativeUnit chooses the unit for the duration and rounds the duration to the unit.
+func relativeUnit(d time.Duration) (int, string) {
+	days := d.Hours() / 24
+	switch abs := math.Abs(d.Seconds()); {
+	case abs < 45:
+		return int(math.Round(d.Seconds())), "second"
+	case abs < 45*60:
+		return int(math.Round(d.Minutes())), "minute"
+	case abs < 22*60*60:
+		return int(math.Round(d.Hours())), "hour"
+	case math.Abs(days) < 6.5:
+		return int(math.Round(days)), "day"
+	case math.Abs(days) < 26:
+		return int(math.Round(days / 7)), "week"
+	case math.Abs(days) < 320:
+		return int(math.Round(days / 30.4375)), "month"
+	default:
+		return int(math.Round(days / 365.25)), "year"
+	}
+}
+
+// FormatDuration formats the duration with the days, the hours, the minutes and the seconds (e.g. `2 hours, 15 minutes`, `2h 15m`),
+// the zero units are omitted, and the milliseconds are only shown if the duration is shorter than a second.
+// A negative duration is prefixed with the minus sign of the locale (e.g. `-2 hours, 15 minutes`).
+func (l *Locale) FormatDuration(d time.Duration, options ...func(*UnitFormat)) string {
+	if d < 0 {
+		return lookupLocaleData(l.locale).symbols(false).minus + l.FormatDuration(-d, options...)
+	}
+	f := newUnitFormat(options...)
+	if d < time.Second && d >= time.Millisecond {
+		return l.FormatUnit(int(d/time.Millisecond), "millisecond", WithWidth(f.width))
+	}
+	var parts []string
+	for _, v := range []struct {
+		unit     string
+		duration time.Duration
+	}{
+		{"day", 24 * time.Hour},
+		{"hour", time.Hour},
+		{"minute", time.Minute},
+		{"second", time.Second},
+	} {
+		if n := int(d / v.duration); n > 0 {
+			parts = append(parts, l.FormatUnit(n, v.unit, WithWidth(f.width)))
+			d -= time.Duration(n) * v.duration
+		}
+	}
+	if len(parts) == 0 {
+		return l.FormatUnit(0, "second", WithWidth(f.width))
+	}
+	return strings.Join(parts, lookupLocale(unitDatas, l.locale).separators[f.width])
+}
+
+// relativeData is the relative time patterns of a locale, a unit has the future and the past patterns.
+type relativeData struct {
+	units [3]map[string][2]string
+	// phrases are used by `WithNumericAuto` (e.g. `-1` day is `yesterday`).
+	phrases map[string]map[int]string
+}
+
+// pattern returns the patterns of the unit, the narrower widths fall back to the wider ones if they are missing.
+func (r *relativeData) pattern(unit string, width Width) ([2]string, bool) {
+	for w := width; w >= WidthLong; w-- {
+		if v, ok := r.units[w][unit]; ok {
+			return v, true
+		}
+	}
+	return [2]string{}, false
+}
+
+var relativeDatas = map[string]*relativeData{
+	"en": {
+		units: [3]map[string][2]string{
+			{
+				"second": {"in {0} seconds | in {0} second | in {0} seconds", "{0} seconds ago | {0} second ago | {0} seconds ago"},
+				"minute": {"in {0} minutes | in {0} minute | in {0} minutes", "{0} minutes ago | {0} minute ago | {0} minutes ago"},
+				"hour":   {"in {0} hours | in {0} hour | in {0} hours", "{0} hours ago | {0} hour ago | {0} hours ago"},
+				"day":    {"in {0} days | in {0} day | in {0} days", "{0} days ago | {0} day ago | {0} days ago"},
+				"week":   {"in {0} weeks | in {0} week | in {0} weeks", "{0} weeks ago | {0} week ago | {0} weeks ago"},
+				"month":  {"in {0} months | in {0} month | in {0} months", "{0} months ago | {0} month ago | {0} months ago"},
+				"year":   {"in {0} years | in {0} year | in {0} years", "{0} years ago | {0} year ago | {0} years ago"},
+			},
+			{
+				"second": {"in {0} sec.", "{0} sec. ago"},
+				"minute": {"in {0} min.", "{0} min. ago"},
+				"hour":   {"in {0} hr.", "{0} hr. ago"},
+				"week":   {"in {0} wk.", "{0} wk. ago"},
+				"month":  {"in {0} mo.", "{0} mo. ago"},
+				"year":   {"in {0} yr.", "{0} yr. ago"},
+			},
+			{
+				"second": {"in {0}s", "{0}s ago"},
+				"minute": {"in {0}m", "{0}m ago"},
+				"hour":   {"in {0}h", "{0}h ago"},
+				"day":    {"in {0}d", "{0}d ago"},
+				"week":   {"in {0}w", "{0}w ago"},
+				"month":  {"in {0}mo", "{0}mo ago"},
+				"year":   {"in {0}y", "{0}y ago"},
+			},
+		},
+		phrases: map[string]map[int]string{
+			"second": {0: "now"},
+			"minute": {0: "this minute"},
+			"hour":   {0: "this hour"},
+			"day":    {-1: "yesterday", 0: "today", 1: "tomorrow"},
+			"week":   {-1: "last week", 0: "this week", 1: "next week"},
+			"month":  {-1: "last month", 0: "this month", 1: "next month"},
+			"year":   {-1: "last year", 0: "this year", 1: "next year"},
+		},
+	},
+	"zh": {
+		units: [3]map[string][2]string{
+			{
+				"second": {"{0}秒钟后", "{0}秒钟前"},
+				"minute": {"{0}分钟后", "{0}分钟前"},
+				"hour":   {"{0}小时后", "{0}小时前"},
+				"day":    {"{0}天后", "{0}天前"},
+				"week":   {"{0}周后", "{0}周前"},
+				"month":  {"{0}个月后", "{0}个月前"},
+				"year":   {"{0}年后", "{0}年前"},
+			},
+			{
+				"second": {"{0}秒后", "{0}秒前"},
+			},
+		},
+		phrases: map[string]map[int]string{
+			"second": {0: "现在"},
+			"day":    {-2: "前天", -1: "昨天", 0: "今天", 1: "明天", 2: "后天"},
+			"week":   {-1: "上周", 0: "本周", 1: "下周"},
+			"month":  {-1: "上个月", 0: "本月", 1: "下个月"},
+			"year":   {-1: "去年", 0: "今年", 1: "明年"},
+		},
+	},
+	"zh-tw": {
+		units: [3]map[string][2]string{
+			{
+				"second": {"{0} 秒後", "{0} 秒前"},
+				"minute": {"{0} 分鐘後", "{0} 分鐘前"},
+				"hour":   {"{0} 小時後", "{0} 小時前"},
+				"day":    {"{0} 天後", "{0} 天前"},
+				"week":   {"{0} 週後", "{0} 週前"},
+				"month":  {"{0} 個月後", "{0} 個月前"},
+				"year":   {"{0} 年後", "{0} 年前"},
+			},
+			{},
+			{
+				"second": {"{0}秒後", "{0}秒前"},
+				"minute": {"{0}分後", "{0}分前"},
+				"hour":   {"{0}小時後", "{0}小時前"},
+				"day":    {"{0}天後", "{0}天前"},
+			},
+		},
+		phrases: map[string]map[int]string{
+			"second": {0: "現在"},
+			"day":    {-2: "前天", -1: "昨天", 0: "今天", 1: "明天", 2: "後天"},
+			"week":   {-1: "上週", 0: "本週", 1: "下週"},
+			"month":  {-1: "上個月", 0: "本月", 1: "下個月"},
+			"year":   {-1: "去年", 0: "今年", 1: "明年"},
+		},
+	},
+	"ja": {
+		units: [3]map[string][2]string{
+			{
+				"second": {"{0} 秒後", "{0} 秒前"},
+				"minute": {"{0} 分後", "{0} 分前"},
+				"hour":   {"{0} 時間後", "{0} 時間前"},
+				"day":    {"{0} 日後", "{0} 日前"},
+				"week":   {"{0} 週間後", "{0} 週間前"},
+				"month":  {"{0} か月後", "{0} か月前"},
+				"year":   {"{0} 年後", "{0} 年前"},
+			},
+			{},
+			{
+				"second": {"{0}秒後", "{0}秒前"},
+				"minute": {"{0}分後", "{0}分前"},
+				"hour":   {"{0}時間後", "{0}時間前"},
+				"day":    {"{0}日後", "{0}日前"},
+			},
+		},
+		phrases: map[string]map[int]string{
+			"second": {0: "今"},
+			"day":    {-2: "一昨日", -1: "昨日", 0: "今日", 1: "明日", 2: "明後日"},
+			"week":   {-1: "先週", 0: "今週", 1: "来週"},
+			"month":  {-1: "先月", 0: "今月", 1: "来月"},
+			"year":   {-1: "昨年", 0: "今年", 1: "来年"},
+		},
+	},
+	"ko": {
+		units: [3]map[string][2]string{
+			{
+				"second": {"{0}초 후", "{0}초 전"},
+				"minute": {"{0}분 후", "{0}분 전"},
+				"hour":   {"{0}시간 후", "{0}시간 전"},
+				"day":    {"{0}일 후", "{0}일 전"},
+				"week":   {"{0}주 후", "{0}주 전"},
+				"month":  {"{0}개월 후", "{0}개월 전"},
+				"year":   {"{0}년 후", "{0}년 전"},
+			},
+		},
+		phrases: map[string]map[int]string{
+			"second": {0: "지금"},
+			"day":    {-2: "그저께", -1: "어제", 0: "오늘", 1: "내일", 2: "모레"},
+			"week":   {-1: "지난주", 0: "이번 주", 1: "다음 주"},
+			"month":  {-1: "지난달", 0: "이번 달", 1: "다음 달"},
+			"year":   {-1: "작년", 0: "올해", 1: "내년"},
+		},
+	},
+	"de": {
+		units: [3]map[string][2]string{
+			{
+				"second": {"in {0} Sekunden | in {0} Sekunde | in {0} Sekunden", "vor {0} Sekunden | vor {0} Sekunde | vor {0} Sekunden"},
+				"minute": {"in {0} Minuten | in {0} Minute | in {0} Minuten", "vor {0} Minuten | vor {0} Minute | vor {0} Minuten"},
+				"hour":   {"in {0} Stunden | in {0} Stunde | in {0} Stunden", "vor {0} Stunden | vor {0} Stunde | vor {0} Stunden"},
+				"day":    {"in {0} Tagen | in {0} Tag | in {0} Tagen", "vor {0} Tagen | vor {0} Tag | vor {0} Tagen"},
+				"week":   {"in {0} Wochen | in {0} Woche | in {0} Wochen", "vor {0} Wochen | vor {0} Woche | vor {0} Wochen"},
+				"month":  {"in {0} Monaten | in {0} Monat | in {0} Monaten", "vor {0} Monaten | vor {0} Monat | vor {0} Monaten"},
+				"year":   {"in {0} Jahren | in {0} Jahr | in {0} Jahren", "vor {0} Jahren | vor {0} Jahr | vor {0} Jahren"},
+			},
+			{
+				"second": {"in {0} Sek.", "vor {0} Sek."},
+				"minute": {"in {0} Min.", "vor {0} Min."},
+				"hour":   {"in {0} Std.", "vor {0} Std."},
+				"week":   {"in {0} Wo.", "vor {0} Wo."},
+				"month":  {"in {0} Mon.", "vor {0} Mon."},
+				"year":   {"in {0} J.", "vor {0} J."},
+			},
+		},
+		phrases: map[string]map[int]string{
+			"second": {0: "jetzt"},
+			"day":    {-2: "vorgestern", -1: "gestern", 0: "heute", 1: "morgen", 2: "übermorgen"},
+			"week":   {-1: "letzte Woche", 0: "diese Woche", 1: "nächste Woche"},
+			"month":  {-1: "letzten Monat", 0: "diesen Monat", 1: "nächsten Monat"},
+			"year":   {-1: "letztes Jahr", 0: "dieses Jahr", 1: "nächstes Jahr"},
+		},
+	},
+	"fr": {
+		units: [3]map[string][2]string{
+			{
+				"second": {"dans {0} seconde | dans {0} secondes", "il y a {0} seconde | il y a {0} secondes"},
+				"minute": {"dans {0} minute | dans {0} minutes", "il y a {0} minute | il y a {0} minutes"},
+				"hour":   {"dans {0} heure | dans {0} heures", "il y a {0} heure | il y a {0} heures"},
+				"day":    {"dans {0} jour | dans {0} jours", "il y a {0} jour | il y a {0} jours"},
+				"week":   {"dans {0} semaine | dans {0} semaines", "il y a {0} semaine | il y a {0} semaines"},
+				"month":  {"dans {0} mois", "il y a {0} mois"},
+				"year":   {"dans {0} an | dans {0} ans", "il y a {0} an | il y a {0} ans"},
+			},
+			{
+				"second": {"dans {0} s", "il y a {0} s"},
+				"minute": {"dans {0} min", "il y a {0} min"},
+				"hour":   {"dans {0} h", "il y a {0} h"},
+				"day":    {"dans {0} j", "il y a {0} j"},
+				"week":   {"dans {0} sem.", "il y a {0} sem."},
+			},
+			{
+				"second": {"+{0} s", "-{0} s"},
+				"minute": {"+{0} min", "-{0} min"},
+				"hour":   {"+{0} h", "-{0} h"},
+				"day":    {"+{0} j", "-{0} j"},
+			},
+		},
+		phrases: map[string]map[int]string{
+			"second": {0: "maintenant"},
+			"day":    {-2: "avant-hier", -1: "hier", 0: "aujourd’hui", 1: "demain", 2: "après-demain"},
+			"week":   {-1: "la semaine dernière", 0: "cette semaine", 1: "la semaine prochaine"},
+			"month":  {-1: "le mois dernier", 0: "ce mois-ci", 1: "le mois prochain"},
+			"year":   {-1: "l’année dernière", 0: "cette année", 1: "l’année prochaine"},
+		},
+	},
+	"es": {
+		units: [3]map[string][2]string{
+			{
+				"second": {"dentro de {0} segundos | dentro de {0} segundo | dentro de {0} segundos", "hace {0} segundos | hace {0} segundo | hace {0} segundos"},
+				"minute": {"dentro de {0} minutos | dentro de {0} minuto | dentro de {0} minutos", "hace {0} minutos | hace {0} minuto | hace {0} minutos"},
+				"hour":   {"dentro de {0} horas | dentro de {0} hora | dentro de {0} horas", "hace {0} horas | hace {0} hora | hace {0} horas"},
+				"day":    {"dentro de {0} días | dentro de {0} día | dentro de {0} días", "hace {0} días | hace {0} día | hace {0} días"},
+				"week":   {"dentro de {0} semanas | dentro de {0} semana | dentro de {0} semanas", "hace {0} semanas | hace {0} semana | hace {0} semanas"},
+				"month":  {"dentro de {0} meses | dentro de {0} mes | dentro de {0} meses", "hace {0} meses | hace {0} mes | hace {0} meses"},
+				"year":   {"dentro de {0} años | dentro de {0} año | dentro de {0} años", "hace {0} años | hace {0} año | hace {0} años"},
+			},
+			{
+				"second": {"dentro de {0} s", "hace {0} s"},
+				"minute": {"dentro de {0} min", "hace {0} min"},
+				"hour":   {"dentro de {0} h", "hace {0} h"},
+			},
+		},
+		phrases: map[string]map[int]string{
+			"second": {0: "ahora"},
+			"day":    {-2: "anteayer", -1: "ayer", 0: "hoy", 1: "mañana", 2: "pasado mañana"},
+			"week":   {-1: "la semana pasada", 0: "esta semana", 1: "la próxima semana"},
+			"month":  {-1: "el mes pasado", 0: "este mes", 1: "el próximo mes"},
+			"year":   {-1: "el año pasado", 0: "este año", 1: "el próximo año"},
+		},
+	},
+}
+
+func init() {
+	relativeDatas["zh-hk"] = relativeDatas["zh-tw"]
+}
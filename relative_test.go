@@ -0,0 +1,122 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatRelativeTime(t *testing.T) {
+	assert := assert.New(t)
+
+	en := newFormatLocale("en-us")
+	tw := newFormatLocale("zh-tw")
+	de := newFormatLocale("de-de")
+	fr := newFormatLocale("fr-fr")
+
+	assert.Equal("3 minutes ago", en.FormatRelativeTime(-3, "minute"))
+	assert.Equal("1 minute ago", en.FormatRelativeTime(-1, "minute"))
+	assert.Equal("in 2 days", en.FormatRelativeTime(2, "day"))
+	assert.Equal("-2", en.FormatRelativeTime(-2, "fortnight"))
+	assert.Equal("1,200", en.FormatRelativeTime(1200, "fortnight", WithNumericAuto()))
+	assert.Equal("in 1,200 years", en.FormatRelativeTime(1200, "year"))
+	assert.Equal("3 分鐘前", tw.FormatRelativeTime(-3, "minute"))
+	assert.Equal("vor 1 Tag", de.FormatRelativeTime(-1, "day"))
+	assert.Equal("il y a 1 heure", fr.FormatRelativeTime(-1, "hour"))
+	assert.Equal("dans 0 jour", fr.FormatRelativeTime(0, "day"))
+
+	// Widths.
+	assert.Equal("3 hr. ago", en.FormatRelativeTime(-3, "hour", WithWidth(WidthShort)))
+	assert.Equal("in 2 days", en.FormatRelativeTime(2, "day", WithWidth(WidthShort)))
+	assert.Equal("3h ago", en.FormatRelativeTime(-3, "hour", WithWidth(WidthNarrow)))
+	assert.Equal("in 2 Tagen", de.FormatRelativeTime(2, "day", WithWidth(WidthNarrow)))
+
+	// Numeric auto.
+	assert.Equal("yesterday", en.FormatRelativeTime(-1, "day", WithNumericAuto()))
+	assert.Equal("next week", en.FormatRelativeTime(1, "week", WithNumericAuto()))
+	assert.Equal("now", en.FormatRelativeTime(0, "second", WithNumericAuto()))
+	assert.Equal("in 3 days", en.FormatRelativeTime(3, "day", WithNumericAuto()))
+	assert.Equal("後天", tw.FormatRelativeTime(2, "day", WithNumericAuto()))
+
+	// Unknown locales fall back to English, and the narrower widths fall back to the wider ones.
+	assert.Equal("2 days ago", newFormatLocale("ru-ru").FormatRelativeTime(-2, "day"))
+	assert.Equal("2주 후", newFormatLocale("ko-kr").FormatRelativeTime(2, "week", WithWidth(WidthNarrow)))
+}
+
+func TestFormatRelative(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Date(2026, time.October, 16, 15, 4, 5, 0, time.UTC)
+	en := newFormatLocale("en-us")
+
+	assert.Equal("now", en.FormatRelative(now.Add(-200*time.Millisecond), now, WithNumericAuto()))
+	assert.Equal("10 seconds ago", en.FormatRelative(now.Add(-10*time.Second), now))
+	assert.Equal("3 minutes ago", en.FormatRelative(now.Add(-3*time.Minute), now))
+	assert.Equal("in 5 hours", en.FormatRelative(now.Add(5*time.Hour), now))
+	assert.Equal("yesterday", en.FormatRelative(now.Add(-26*time.Hour), now, WithNumericAuto()))
+	assert.Equal("in 2 weeks", en.FormatRelative(now.AddDate(0, 0, 15), now))
+	assert.Equal("3 months ago", en.FormatRelative(now.AddDate(0, -3, 0), now))
+	assert.Equal("in 2 years", en.FormatRelative(now.AddDate(2, 0, 0), now))
+}
+
+func TestFormatDuration(t *testing.T) {
+	assert := assert.New(t)
+
+	en := newFormatLocale("en-us")
+	tw := newFormatLocale("zh-tw")
+	fr := newFormatLocale("fr-fr")
+	de := newFormatLocale("de-de")
+	d := 2*time.Hour + 15*time.Minute
+
+	assert.Equal("2 hours, 15 minutes", en.FormatDuration(d))
+	assert.Equal("2 hr, 15 min", en.FormatDuration(d, WithWidth(WidthShort)))
+	assert.Equal("2h 15m", en.FormatDuration(d, WithWidth(WidthNarrow)))
+	assert.Equal("1 day, 1 hour, 1 second", en.FormatDuration(25*time.Hour+time.Second))
+	assert.Equal("2 h 15 min", fr.FormatDuration(d, WithWidth(WidthShort)))
+	assert.Equal("2 heures, 15 minutes", fr.FormatDuration(d))
+	assert.Equal("1 Stunde, 1 Minute", de.FormatDuration(time.Hour+time.Minute))
+	assert.Equal("2 小時 15 分鐘", tw.FormatDuration(d))
+	assert.Equal("2小時15分", tw.FormatDuration(d, WithWidth(WidthNarrow)))
+
+	assert.Equal("0 seconds", en.FormatDuration(0))
+	assert.Equal("500 milliseconds", en.FormatDuration(500*time.Millisecond))
+	assert.Equal("-15 minutes", en.FormatDuration(-15*time.Minute))
+	assert.Equal("-2h 15m", en.FormatDuration(-d, WithWidth(WidthNarrow)))
+	assert.Equal("-500 milliseconds", en.FormatDuration(-500*time.Millisecond))
+}
+
+func TestRelativeTemplateFuncs(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"test_posted":  "Posted {{ relativeTime .Time }}",
+			"test_elapsed": "Took {{ duration .Elapsed }} ({{ duration .Elapsed \"narrow\" }})",
+		},
+		"zh-tw": map[string]string{
+			"test_posted": "{{ relativeTime .Time }}發表",
+		},
+	})
+	data := map[string]any{
+		"Time":    time.Now().Add(-3 * time.Minute),
+		"Elapsed": 2*time.Hour + 15*time.Minute,
+	}
+	assert.Equal("Posted 3 minutes ago", i.NewLocale("en-us").String("test_posted", data))
+	assert.Equal("3 分鐘前發表", i.NewLocale("zh-tw").String("test_posted", data))
+	assert.Equal("Took 2 hours, 15 minutes (2h 15m)", i.NewLocale("en-us").String("test_elapsed", data))
+}
+
+func TestPluralIndex(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"test_single": "{{ .Count }} item(s)",
+		},
+	})
+	assert.Equal("1 item(s)", i.NewLocale("en-us").Number("test_single", 1, map[string]any{"Count": 1}))
+	assert.Equal("5 item(s)", i.NewLocale("en-us").Number("test_single", 5, map[string]any{"Count": 5}))
+}
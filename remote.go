@@ -0,0 +1,277 @@
+package i18n
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RemoteSource is a source that fetches the catalogs of the locales from an HTTP server, such as a static file server.
+// The catalogs are requested with `If-None-Match` and `If-Modified-Since`, and the last good catalogs are used
+// when the server is unavailable, they can be saved in a cache directory so they survive the restarts.
+type RemoteSource struct {
+	url      string
+	locales  []string
+	client   *http.Client
+	interval time.Duration
+	cacheDir string
+	onError  func(error)
+	mutex    sync.Mutex
+	catalogs map[string]*remoteCatalog
+	// applied is the catalogs that were swapped in by `Refresh`, so the catalogs are swapped in again if `Reload` failed.
+	applied map[string]*remoteCatalog
+}
+
+// remoteCatalog is the last good catalog of a locale, it's saved in the cache directory as-is.
+type remoteCatalog struct {
+	ETag         string          `json:"etag,omitempty"`
+	LastModified string          `json:"last_modified,omitempty"`
+	Translations json.RawMessage `json:"translations"`
+	entries      []Entry
+}
+
+// WithRemoteClient changes the client that sends the requests, defaults to `http.DefaultClient`.
+func WithRemoteClient(c *http.Client) func(*RemoteSource) {
+	return func(s *RemoteSource) {
+		s.client = c
+	}
+}
+
+// WithRemoteInterval changes how often `Watch` refreshes the catalogs, defaults to 5 minutes.
+func WithRemoteInterval(d time.Duration) func(*RemoteSource) {
+	return func(s *RemoteSource) {
+		s.interval = d
+	}
+}
+
+// WithRemoteCacheDir saves the last good catalogs in the directory, they're used when the server is unavailable on startup.
+func WithRemoteCacheDir(dir string) func(*RemoteSource) {
+	return func(s *RemoteSource) {
+		s.cacheDir = dir
+	}
+}
+
+// WithRemoteErrorHandler reports the errors that didn't fail the loading, such as the catalogs that fell back
+// to the last good ones and the errors of `Watch`.
+func WithRemoteErrorHandler(f func(error)) func(*RemoteSource) {
+	return func(s *RemoteSource) {
+		s.onError = f
+	}
+}
+
+// NewRemoteSource creates a source that fetches the catalog of each locale from the URL, `{locale}` in the URL
+// is replaced by the locale (e.g. `https://example.com/i18n/{locale}.json`). The catalogs are JSON like `NewHTTPSource`
+// without the locale keys, such as `{"hello": "Hello"}`.
+func NewRemoteSource(url string, locales []string, options ...func(*RemoteSource)) *RemoteSource {
+	s := &RemoteSource{
+		url:      url,
+		locales:  locales,
+		client:   http.DefaultClient,
+		interval: 5 * time.Minute,
+		catalogs: make(map[string]*remoteCatalog),
+	}
+	for _, o := range options {
+		o(s)
+	}
+	return s
+}
+
+// Load fetches the catalogs, an error is returned only if a catalog can't be fetched and there's no last good one.
+func (s *RemoteSource) Load(ctx context.Context) ([]Entry, error) {
+	entries, _, err := s.fetch(ctx)
+	return entries, err
+}
+
+// Refresh fetches the catalogs and swaps them in `I18n` by `Reload` if any of them were changed. `Reload` replaces
+// all the translations, so pass the other sources of `I18n` (e.g. `NewFSSource`) to keep them, the catalogs override them.
+func (s *RemoteSource) Refresh(ctx context.Context, i *I18n, sources ...Source) error {
+	entries, catalogs, err := s.fetch(ctx)
+	if err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	changed := len(catalogs) != len(s.applied)
+	for locale, c := range catalogs {
+		if s.applied[locale] != c {
+			changed = true
+		}
+	}
+	s.mutex.Unlock()
+	if !changed {
+		return nil
+	}
+	if err := i.Reload(ctx, append(sources[:len(sources):len(sources)], SourceFunc(func(context.Context) ([]Entry, error) {
+		return entries, nil
+	}))...); err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	s.applied = catalogs
+	s.mutex.Unlock()
+	return nil
+}
+
+// Watch refreshes the catalogs of `I18n` with the other sources like `Refresh` on the interval until the context is done,
+// it blocks so call it in a goroutine.
+func (s *RemoteSource) Watch(ctx context.Context, i *I18n, sources ...Source) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := s.Refresh(ctx, i, sources...); err != nil {
+			s.report(err)
+		}
+	}
+}
+
+// fetch requests the catalogs and returns them with their entries, the unchanged catalogs are the same as the last fetch.
+func (s *RemoteSource) fetch(ctx context.Context) ([]Entry, map[string]*remoteCatalog, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var entries []Entry
+	catalogs := make(map[string]*remoteCatalog, len(s.locales))
+	for _, locale := range s.locales {
+		last, ok := s.catalogs[locale]
+		if !ok {
+			last = s.readCache(locale)
+		}
+		c, err := s.request(ctx, locale, last)
+		if err != nil {
+			if last == nil {
+				return nil, nil, err
+			}
+			s.report(err)
+			c = last
+		}
+		if c != s.catalogs[locale] {
+			s.catalogs[locale] = c
+			if c != last {
+				s.writeCache(locale, c)
+			}
+		}
+		catalogs[locale] = c
+		entries = append(entries, c.entries...)
+	}
+	return entries, catalogs, nil
+}
+
+// request fetches the catalog of the locale, the last catalog is returned if it wasn't modified.
+func (s *RemoteSource) request(ctx context.Context, locale string, last *remoteCatalog) (*remoteCatalog, error) {
+	url := strings.ReplaceAll(s.url, "{locale}", locale)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if last != nil {
+		if last.ETag != "" {
+			req.Header.Set("If-None-Match", last.ETag)
+		}
+		if last.LastModified != "" {
+			req.Header.Set("If-Modified-Since", last.LastModified)
+		}
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotModified && last != nil {
+		return last, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: unexpected status %s", url, resp.Status)
+	}
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("GET %s: %w", url, err)
+	}
+	c := &remoteCatalog{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		Translations: b,
+	}
+	if c.entries, err = unmarshalEntries(locale, b); err != nil {
+		return nil, fmt.Errorf("GET %s: %w", url, err)
+	}
+	return c, nil
+}
+
+// readCache returns the catalog of the locale in the cache directory, nil if there's none.
+func (s *RemoteSource) readCache(locale string) *remoteCatalog {
+	if s.cacheDir == "" {
+		return nil
+	}
+	b, err := os.ReadFile(filepath.Join(s.cacheDir, locale+".json"))
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			s.report(err)
+		}
+		return nil
+	}
+	var c remoteCatalog
+	if err := json.Unmarshal(b, &c); err != nil {
+		s.report(fmt.Errorf("%s: %w", filepath.Join(s.cacheDir, locale+".json"), err))
+		return nil
+	}
+	if c.entries, err = unmarshalEntries(locale, c.Translations); err != nil {
+		s.report(fmt.Errorf("%s: %w", filepath.Join(s.cacheDir, locale+".json"), err))
+		return nil
+	}
+	return &c
+}
+
+// writeCache saves the catalog of the locale in the cache directory, the file is replaced by renaming
+// so the other processes won't read a half-written one.
+func (s *RemoteSource) writeCache(locale string, c *remoteCatalog) {
+	if s.cacheDir == "" {
+		return
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		s.report(err)
+		return
+	}
+	if err := os.MkdirAll(s.cacheDir, 0o755); err != nil {
+		s.report(err)
+		return
+	}
+	f, err := os.CreateTemp(s.cacheDir, locale+".*.tmp")
+	if err != nil {
+		s.report(err)
+		return
+	}
+	_, err = f.Write(b)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), filepath.Join(s.cacheDir, locale+".json"))
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		s.report(err)
+	}
+}
+
+// report
+func (s *RemoteSource) report(err error) {
+	if s.onError != nil {
+		s.onError(err)
+	}
+}
@@ -0,0 +1,264 @@
+package i18n
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testCatalogServer serves the catalogs of the locales with the ETags, or fails if it's down.
+type testCatalogServer struct {
+	mutex    sync.Mutex
+	catalogs map[string]string
+	down     bool
+	requests []string
+}
+
+func (s *testCatalogServer) set(locale, catalog string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.catalogs[locale] = catalog
+}
+
+func (s *testCatalogServer) setDown(down bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.down = down
+}
+
+func (s *testCatalogServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.down {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	locale := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/"), ".json")
+	catalog, ok := s.catalogs[locale]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	// The ETag is used for `en-us` and the Last-Modified for the others.
+	var status int
+	if locale == "en-us" {
+		etag := `"` + catalog + `"`
+		w.Header().Set("ETag", etag)
+		if r.Header.Get("If-None-Match") == etag {
+			status = http.StatusNotModified
+		}
+	} else {
+		modified := time.Date(2023, 1, len(catalog), 0, 0, 0, 0, time.UTC).Format(http.TimeFormat)
+		w.Header().Set("Last-Modified", modified)
+		if r.Header.Get("If-Modified-Since") == modified {
+			status = http.StatusNotModified
+		}
+	}
+	if status == http.StatusNotModified {
+		s.requests = append(s.requests, locale+" 304")
+		w.WriteHeader(status)
+		return
+	}
+	s.requests = append(s.requests, locale+" 200")
+	w.Write([]byte(catalog))
+}
+
+func (s *testCatalogServer) takeRequests() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	v := s.requests
+	s.requests = nil
+	return v
+}
+
+func TestRemoteSource(t *testing.T) {
+	assert := assert.New(t)
+
+	server := &testCatalogServer{
+		catalogs: map[string]string{
+			"en-us": `{"hello": "Hello", "bye": {"text": "Bye", "description": "On the sign out page"}}`,
+			"zh-tw": `{"hello": "你好"}`,
+		},
+	}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	var errs []error
+	dir := filepath.Join(t.TempDir(), "i18n")
+	s := NewRemoteSource(ts.URL+"/{locale}.json", []string{"en-us", "zh-tw"},
+		WithRemoteClient(ts.Client()),
+		WithRemoteCacheDir(dir),
+		WithRemoteErrorHandler(func(err error) {
+			errs = append(errs, err)
+		}),
+	)
+	i := New("en-us")
+	assert.NoError(s.Refresh(context.Background(), i))
+	assert.Equal([]string{"en-us 200", "zh-tw 200"}, server.takeRequests())
+
+	en, tw := i.NewLocale("en-us"), i.NewLocale("zh-tw")
+	assert.Equal("Hello", en.String("hello"))
+	assert.Equal("你好", tw.String("hello"))
+	assert.Equal("Bye", tw.String("bye"))
+	assert.Equal(map[string]string{"description": "On the sign out page"}, i.Metadata("en-us", "bye"))
+
+	// Not modified.
+	compiled := i.compiled.Load()
+	assert.NoError(s.Refresh(context.Background(), i))
+	assert.Equal([]string{"en-us 304", "zh-tw 304"}, server.takeRequests())
+	assert.Same(compiled, i.compiled.Load())
+
+	// Modified, the existing locales use the new catalog.
+	server.set("zh-tw", `{"hello": "哈囉", "bye": "再見"}`)
+	assert.NoError(s.Refresh(context.Background(), i))
+	assert.Equal([]string{"en-us 304", "zh-tw 200"}, server.takeRequests())
+	assert.Equal("哈囉", tw.String("hello"))
+	assert.Equal("再見", tw.String("bye"))
+
+	// Unavailable, the last good catalogs are kept.
+	server.setDown(true)
+	assert.NoError(s.Refresh(context.Background(), i))
+	assert.Len(errs, 2)
+	assert.EqualError(errs[0], "GET "+ts.URL+"/en-us.json: unexpected status 503 Service Unavailable")
+	assert.Equal("哈囉", tw.String("hello"))
+
+	// The cache directory is used on startup.
+	b, err := os.ReadFile(filepath.Join(dir, "zh-tw.json"))
+	assert.NoError(err)
+	assert.Contains(string(b), "哈囉")
+
+	errs = nil
+	cached := NewRemoteSource(ts.URL+"/{locale}.json", []string{"en-us", "zh-tw"}, WithRemoteClient(ts.Client()), WithRemoteCacheDir(dir), WithRemoteErrorHandler(func(err error) {
+		errs = append(errs, err)
+	}))
+	i = New("en-us")
+	assert.NoError(i.LoadSource(context.Background(), cached))
+	assert.Equal("哈囉", i.NewLocale("zh-tw").String("hello"))
+	assert.Len(errs, 2)
+
+	server.setDown(false)
+	assert.NoError(cached.Refresh(context.Background(), i))
+	assert.Equal([]string{"en-us 304", "zh-tw 304"}, server.takeRequests())
+
+	// No last good catalog.
+	server.setDown(true)
+	i = New("en-us")
+	assert.EqualError(i.LoadSource(context.Background(), NewRemoteSource(ts.URL+"/{locale}.json", []string{"en-us"})), "GET "+ts.URL+"/en-us.json: unexpected status 503 Service Unavailable")
+	server.setDown(false)
+	assert.EqualError(i.LoadSource(context.Background(), NewRemoteSource(ts.URL+"/{locale}.json", []string{"ja"})), "GET "+ts.URL+"/ja.json: unexpected status 404 Not Found")
+}
+
+func TestRemoteSourceWithSources(t *testing.T) {
+	assert := assert.New(t)
+
+	server := &testCatalogServer{
+		catalogs: map[string]string{
+			"zh-tw": `{"hello": "你好", "message_a": "遠端訊息 A"}`,
+		},
+	}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	s := NewRemoteSource(ts.URL+"/{locale}.json", []string{"zh-tw"}, WithRemoteClient(ts.Client()))
+	i := New("zh-tw")
+	files := i.NewFSSource(testTranslationFS, "test/zh-tw.json", "test/zh_TW.json")
+	assert.NoError(i.LoadSource(context.Background(), files, s))
+
+	tw := i.NewLocale("zh-tw")
+	assert.Equal("你好", tw.String("hello"))
+	assert.Equal("遠端訊息 A", tw.String("message_a"))
+	assert.Equal("訊息 B", tw.String("message_b"))
+
+	// The translations of the files are kept and overridden by the catalogs.
+	server.set("zh-tw", `{"hello": "哈囉"}`)
+	assert.NoError(s.Refresh(context.Background(), i, files))
+	assert.Equal("哈囉", tw.String("hello"))
+	assert.Equal("訊息 A", tw.String("message_a"))
+	assert.Equal("訊息 B", tw.String("message_b"))
+	assert.Equal(map[string]string{"path": "test/zh_TW.json"}, i.Metadata("zh-tw", "message_b"))
+
+	// The catalogs are swapped in by the next refresh if the other sources failed.
+	err := errors.New("unavailable")
+	server.set("zh-tw", `{"hello": "嗨"}`)
+	assert.Equal(err, s.Refresh(context.Background(), i, SourceFunc(func(context.Context) ([]Entry, error) {
+		return nil, err
+	})))
+	assert.Equal("哈囉", tw.String("hello"))
+	server.takeRequests()
+
+	assert.NoError(s.Refresh(context.Background(), i, files))
+	assert.Equal([]string{"zh-tw 304"}, server.takeRequests())
+	assert.Equal("嗨", tw.String("hello"))
+	assert.Equal("訊息 B", tw.String("message_b"))
+}
+
+func TestRemoteSourceWatch(t *testing.T) {
+	assert := assert.New(t)
+
+	server := &testCatalogServer{
+		catalogs: map[string]string{
+			"en-us": `{"hello": "Hello"}`,
+		},
+	}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	s := NewRemoteSource(ts.URL+"/{locale}.json", []string{"en-us"}, WithRemoteClient(ts.Client()), WithRemoteInterval(time.Millisecond))
+	i := New("en-us")
+	base := NewMapSource(map[string]map[string]string{"en-us": {"bye": "Bye"}})
+	assert.NoError(i.LoadSource(context.Background(), base, s))
+	l := i.NewLocale("en-us")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.Watch(ctx, i, base)
+		close(done)
+	}()
+	go func() {
+		for ctx.Err() == nil {
+			l.String("hello")
+		}
+	}()
+
+	server.set("en-us", `{"hello": "Hi"}`)
+	assert.Eventually(func() bool {
+		return l.String("hello") == "Hi"
+	}, time.Second, time.Millisecond)
+	cancel()
+	<-done
+	assert.Equal("Bye", l.String("bye"))
+}
+
+func TestReload(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us", WithFallback(map[string][]string{"zh-tw": {"zh-cn"}}))
+	assert.NoError(i.LoadMap(map[string]map[string]string{
+		"en-us": {"hello": "Hello", "bye": "Bye"},
+	}))
+	assert.NoError(i.Reload(context.Background(), NewMapSource(map[string]map[string]string{
+		"en-us": {"hello": "Hi", "bye": "Bye"},
+		"zh-cn": {"hello": "你好"},
+		"zh-tw": {"bye": "再見"},
+	})))
+	assert.Equal("Hi", i.NewLocale("en-us").String("hello"))
+	assert.Equal("你好", i.NewLocale("zh-tw").String("hello"))
+	assert.Equal("再見", i.NewLocale("zh-tw").String("bye"))
+
+	// The translations are kept if the source failed.
+	err := errors.New("unavailable")
+	assert.Equal(err, i.Reload(context.Background(), SourceFunc(func(context.Context) ([]Entry, error) {
+		return nil, err
+	})))
+	assert.Equal("Hi", i.NewLocale("en-us").String("hello"))
+}
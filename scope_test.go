@@ -0,0 +1,85 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScope(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"checkout.title":                  "Checkout",
+			"checkout.items":                  "No items | 1 item | {{ .Count }} items",
+			"checkout.Pay <verb>":             "Pay now",
+			"checkout.payment.card":           "Credit card",
+			"checkout.thanks [female]":        "Thank you, madam!",
+			"checkout.thanks":                 "Thank you!",
+			"cancel":                          "Cancel",
+			"Back <verb>":                     "Go back",
+			"checkout.payment.Back <verb>":    "Back to payment",
+			"checkout.payment.notice [other]": "Payment notice",
+		},
+		"zh-tw": map[string]string{
+			"checkout.title": "結帳",
+			"cancel":         "取消",
+		},
+	})
+	l := i.NewLocale("en-us")
+
+	checkout := l.Scope("checkout")
+	assert.Equal("Checkout", checkout.String("title"))
+	assert.Equal("3 items", checkout.Number("items", 3))
+	assert.Equal("Pay now", checkout.StringX("Pay", "verb"))
+	assert.Equal("Thank you, madam!", checkout.Select("thanks", "female"))
+	assert.Equal("Thank you!", checkout.Select("thanks", "male"))
+	assert.Equal("en-us", checkout.Locale())
+	assert.Equal("Checkout", l.String("checkout.title"))
+
+	// Nested.
+	payment := checkout.Scope("payment")
+	assert.Equal("Credit card", payment.String("card"))
+	assert.Equal("Back to payment", payment.StringX("Back", "verb"))
+	assert.Equal("Payment notice", payment.Select("notice", "male"))
+
+	// Missing.
+	assert.Equal("cancel", checkout.String("cancel"))
+	assert.Equal("Back", checkout.StringX("Back", "verb"))
+	assert.Equal("Hello, Yami", checkout.String("Hello, {{ .Name }}", map[string]any{"Name": "Yami"}))
+
+	// Unscoped fallback.
+	fallback := l.Scope("checkout", WithUnscopedFallback())
+	assert.Equal("Checkout", fallback.String("title"))
+	assert.Equal("Cancel", fallback.String("cancel"))
+	assert.Equal("Go back", fallback.StringX("Back", "verb"))
+	assert.Equal("Back to payment", fallback.Scope("payment").StringX("Back", "verb"))
+	assert.Equal("取消", i.NewLocale("zh-tw").Scope("checkout", WithUnscopedFallback()).String("cancel"))
+	assert.Equal("結帳", i.NewLocale("zh-tw").Scope("checkout").String("title"))
+
+	// Messages.
+	assert.Equal("Checkout", NewMessage("title").Translate(checkout))
+}
+
+func TestFileNamespace(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("zh-tw", WithFileNamespace())
+	assert.NoError(i.LoadFiles("test/zh-tw.json", "test/zh_tw.hello.json"))
+
+	l := i.NewLocale("zh-tw")
+	assert.Equal("訊息 A", l.String("message_a"))
+	assert.Equal("訊息 C", l.String("hello.message_c"))
+	assert.Equal("訊息 C", l.Scope("hello").String("message_c"))
+	assert.Equal("message_c", l.String("message_c"))
+
+	i = New("zh-tw", WithFileNamespace())
+	assert.NoError(i.LoadFS(testTranslationFS, "test/*.json"))
+	assert.Equal("訊息 C", i.NewLocale("zh-tw").String("hello.message_c"))
+
+	assert.Equal("", i.namespace("zh_tw.json"))
+	assert.Equal("hello.", i.namespace("languages/zh_tw.hello.json"))
+	assert.Equal("checkout.payment.", i.namespace("zh_tw.checkout.payment.yml"))
+}
@@ -0,0 +1,54 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelect(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"profile_updated [male]":   "{{ .Name }} updated his profile",
+			"profile_updated [female]": "{{ .Name }} updated her profile",
+			"profile_updated [other]":  "{{ .Name }} updated their profile",
+			"photos_added [male]":      "{{ .Name }} added a photo to his album | {{ .Name }} added {{ .Count }} photos to his album",
+			"photos_added [female]":    "{{ .Name }} added a photo to her album | {{ .Name }} added {{ .Count }} photos to her album",
+			"photos_added":             "{{ .Name }} added a photo to their album | {{ .Name }} added {{ .Count }} photos to their album",
+			"Invite <admin> [female]":  "Invite her as an admin",
+			"Invite <admin>":           "Invite them as an admin",
+		},
+		"zh-tw": map[string]string{
+			"profile_updated":         "{{ .Name }} 更新了個人檔案",
+			"Invite <admin> [female]": "邀請她成為管理員",
+		},
+	})
+	en := i.NewLocale("en-us")
+	data := map[string]any{
+		"Name":  "Yami",
+		"Count": 3,
+	}
+	assert.Equal("Yami updated his profile", en.Select("profile_updated", "male", data))
+	assert.Equal("Yami updated her profile", en.Select("profile_updated", "female", data))
+	assert.Equal("Yami updated their profile", en.Select("profile_updated", "other", data))
+	assert.Equal("Yami updated their profile", en.Select("profile_updated", "unknown", data))
+
+	// Plural.
+	assert.Equal("Yami added a photo to her album", en.SelectNumber("photos_added", "female", 1, data))
+	assert.Equal("Yami added 3 photos to her album", en.SelectNumber("photos_added", "female", 3, data))
+	assert.Equal("Yami added 3 photos to their album", en.SelectNumber("photos_added", "unknown", 3, data))
+
+	// Context.
+	assert.Equal("Invite her as an admin", en.SelectX("Invite", "admin", "female"))
+	assert.Equal("Invite them as an admin", en.SelectX("Invite", "admin", "male"))
+
+	// The translations of the current locale are preferred over the variants of the fallback locale.
+	tw := i.NewLocale("zh-tw")
+	assert.Equal("Yami 更新了個人檔案", tw.Select("profile_updated", "female", data))
+	assert.Equal("邀請她成為管理員", tw.SelectX("Invite", "admin", "female"))
+	assert.Equal("Invite them as an admin", tw.SelectX("Invite", "admin", "male"))
+	assert.Equal("Yami added 3 photos to his album", tw.SelectNumber("photos_added", "male", 3, data))
+}
@@ -0,0 +1,323 @@
+package i18n
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+)
+
+// Entry is a translation that was provided by a `Source`, the metadata is the extra information
+// of the translation such as the description for the translators or the time it was updated.
+type Entry struct {
+	Locale   string
+	Name     string
+	Text     string
+	Metadata map[string]string
+}
+
+// Source provides the translations from somewhere other than the files, such as the databases or the HTTP endpoints.
+type Source interface {
+	Load(ctx context.Context) ([]Entry, error)
+}
+
+// SourceFunc is an adapter to use the ordinary functions as `Source`.
+type SourceFunc func(ctx context.Context) ([]Entry, error)
+
+// Load calls `f(ctx)`.
+func (f SourceFunc) Load(ctx context.Context) ([]Entry, error) {
+	return f(ctx)
+}
+
+// LoadSource loads the translations from the sources, the translations of the later sources override the earlier ones.
+func (i *I18n) LoadSource(ctx context.Context, sources ...Source) error {
+	data, metadata, err := loadSources(ctx, sources)
+	if err != nil {
+		return err
+	}
+	return i.update(func(c *catalog) error {
+		return i.loadEntries(c, data, metadata)
+	})
+}
+
+// Reload loads the translations from the sources into a new catalog and swaps it in atomically when it's done.
+// The current translations are replaced as a whole and kept as-is if any of the sources failed.
+func (i *I18n) Reload(ctx context.Context, sources ...Source) error {
+	data, metadata, err := loadSources(ctx, sources)
+	if err != nil {
+		return err
+	}
+	i.loadMutex.Lock()
+	defer i.loadMutex.Unlock()
+
+	c := newCatalog()
+	if err := i.loadEntries(c, data, metadata); err != nil {
+		return err
+	}
+	i.compiled.Store(c)
+	return nil
+}
+
+// loadSources reads the entries of the sources as the translations and the metadata that are keyed by the locales.
+func loadSources(ctx context.Context, sources []Source) (map[string]map[string]string, map[string]map[string]map[string]string, error) {
+	data := make(map[string]map[string]string)
+	metadata := make(map[string]map[string]map[string]string)
+
+	for _, s := range sources {
+		entries, err := s.Load(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, e := range entries {
+			locale := nameInsenstive(e.Locale)
+			if _, ok := data[locale]; !ok {
+				data[locale] = make(map[string]string)
+				metadata[locale] = make(map[string]map[string]string)
+			}
+			data[locale][e.Name] = e.Text
+			if e.Metadata != nil {
+				metadata[locale][e.Name] = e.Metadata
+			} else {
+				delete(metadata[locale], e.Name)
+			}
+		}
+	}
+	return data, metadata, nil
+}
+
+// loadEntries compiles the translations into the catalog with their metadata.
+func (i *I18n) loadEntries(c *catalog, data map[string]map[string]string, metadata map[string]map[string]map[string]string) error {
+	if err := i.loadMap(c, data); err != nil {
+		return err
+	}
+	for locale, v := range metadata {
+		c.metadata[locale] = v
+	}
+	return nil
+}
+
+// Metadata returns the metadata of the translation that was loaded by `LoadSource`, nil if there's no metadata.
+func (i *I18n) Metadata(locale, name string) map[string]string {
+	return i.compiled.Load().metadata[nameInsenstive(locale)][name]
+}
+
+// NewMapSource creates a source of the translations in the map that is keyed by the locales, like `LoadMap`.
+func NewMapSource(languages map[string]map[string]string) Source {
+	return SourceFunc(func(ctx context.Context) ([]Entry, error) {
+		var entries []Entry
+		for locale, translations := range languages {
+			for name, text := range translations {
+				entries = append(entries, Entry{
+					Locale: locale,
+					Name:   name,
+					Text:   text,
+				})
+			}
+		}
+		return entries, nil
+	})
+}
+
+// NewFSSource creates a source of the translation files in the `fs.FS` that match the patterns,
+// the files are read like `LoadFS` and the `path` metadata is the path of the file.
+func (i *I18n) NewFSSource(fsys fs.FS, patterns ...string) Source {
+	return SourceFunc(func(ctx context.Context) ([]Entry, error) {
+		var files []string
+		for _, pattern := range patterns {
+			v, err := fs.Glob(fsys, pattern)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, v...)
+		}
+		return i.loadFiles(ctx, func(name string) ([]byte, error) {
+			return fs.ReadFile(fsys, name)
+		}, files)
+	})
+}
+
+// loadFiles reads the translation files as the entries, the entries of a file share the same `path` metadata.
+func (i *I18n) loadFiles(ctx context.Context, readFile func(string) ([]byte, error), files []string) ([]Entry, error) {
+	var entries []Entry
+	for _, v := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		b, err := readFile(v)
+		if err != nil {
+			return nil, err
+		}
+		var trans map[string]string
+		if err := i.unmarshal(v, b, &trans); err != nil {
+			return nil, err
+		}
+		locale, namespace, err := i.fileLocale(v)
+		if err != nil {
+			return nil, err
+		}
+		metadata := map[string]string{"path": v}
+		for name, text := range trans {
+			entries = append(entries, Entry{
+				Locale:   locale,
+				Name:     namespace + name,
+				Text:     text,
+				Metadata: metadata,
+			})
+		}
+	}
+	return entries, nil
+}
+
+// NewSQLSource creates a source that queries the translations from the database. The first three columns of the query
+// should be the locale, the name and the text, the rest of the columns are the metadata that are named by the column names,
+// such as `SELECT locale, name, text, description FROM translations`. The rows with a `NULL` text are skipped.
+func NewSQLSource(db *sql.DB, query string, args ...any) Source {
+	return SourceFunc(func(ctx context.Context) ([]Entry, error) {
+		rows, err := db.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		columns, err := rows.Columns()
+		if err != nil {
+			return nil, err
+		}
+		if len(columns) < 3 {
+			return nil, fmt.Errorf("the query should select the locale, the name and the text, got %d columns", len(columns))
+		}
+		var entries []Entry
+		for rows.Next() {
+			values := make([]sql.NullString, len(columns))
+			dest := make([]any, len(columns))
+			for j := range values {
+				dest[j] = &values[j]
+			}
+			if err := rows.Scan(dest...); err != nil {
+				return nil, err
+			}
+			if !values[2].Valid {
+				continue
+			}
+			e := Entry{
+				Locale: values[0].String,
+				Name:   values[1].String,
+				Text:   values[2].String,
+			}
+			for j, v := range values[3:] {
+				if !v.Valid {
+					continue
+				}
+				if e.Metadata == nil {
+					e.Metadata = make(map[string]string)
+				}
+				e.Metadata[columns[j+3]] = v.String
+			}
+			entries = append(entries, e)
+		}
+		return entries, rows.Err()
+	})
+}
+
+// HTTPSource is a source that fetches the translations from an HTTP endpoint.
+type HTTPSource struct {
+	url    string
+	client *http.Client
+	header http.Header
+}
+
+// WithHTTPClient changes the client that sends the requests, defaults to `http.DefaultClient`.
+func WithHTTPClient(c *http.Client) func(*HTTPSource) {
+	return func(s *HTTPSource) {
+		s.client = c
+	}
+}
+
+// WithHTTPHeader adds the header to the requests, such as `Authorization`.
+func WithHTTPHeader(key, value string) func(*HTTPSource) {
+	return func(s *HTTPSource) {
+		s.header.Add(key, value)
+	}
+}
+
+// NewHTTPSource creates a source that fetches the translations in JSON from the URL, the JSON is keyed by the locales
+// like `{"en-us": {"hello": "Hello"}}`. The translations can be objects with the `text` and the other fields as the metadata,
+// such as `{"hello": {"text": "Hello", "description": "The greeting on the home page"}}`.
+func NewHTTPSource(url string, options ...func(*HTTPSource)) *HTTPSource {
+	s := &HTTPSource{
+		url:    url,
+		client: http.DefaultClient,
+		header: make(http.Header),
+	}
+	for _, o := range options {
+		o(s)
+	}
+	return s
+}
+
+// Load fetches the translations.
+func (s *HTTPSource) Load(ctx context.Context) ([]Entry, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header = s.header.Clone()
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: unexpected status %s", s.url, resp.Status)
+	}
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var languages map[string]json.RawMessage
+	if err := json.Unmarshal(b, &languages); err != nil {
+		return nil, fmt.Errorf("GET %s: %w", s.url, err)
+	}
+	var entries []Entry
+	for locale, v := range languages {
+		e, err := unmarshalEntries(locale, v)
+		if err != nil {
+			return nil, fmt.Errorf("GET %s: %w", s.url, err)
+		}
+		entries = append(entries, e...)
+	}
+	return entries, nil
+}
+
+// unmarshalEntries unmarshals the JSON translations of a locale, the translations are the strings
+// or the objects with the `text` and the metadata.
+func unmarshalEntries(locale string, data []byte) ([]Entry, error) {
+	var translations map[string]json.RawMessage
+	if err := json.Unmarshal(data, &translations); err != nil {
+		return nil, err
+	}
+	entries := make([]Entry, 0, len(translations))
+	for name, v := range translations {
+		e := Entry{
+			Locale: locale,
+			Name:   name,
+		}
+		if err := json.Unmarshal(v, &e.Text); err != nil {
+			if err := json.Unmarshal(v, &e.Metadata); err != nil {
+				return nil, fmt.Errorf("%s: %q should be a string or an object of strings", locale, name)
+			}
+			e.Text = e.Metadata["text"]
+			delete(e.Metadata, "text")
+			if len(e.Metadata) == 0 {
+				e.Metadata = nil
+			}
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
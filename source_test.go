@@ -0,0 +1,203 @@
+package i18n
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testConnector is a `database/sql` driver that returns the same rows for all the queries.
+type testConnector struct {
+	columns []string
+	rows    [][]driver.Value
+	query   string
+}
+
+func (c *testConnector) Connect(context.Context) (driver.Conn, error) { return c, nil }
+func (c *testConnector) Driver() driver.Driver                        { return nil }
+func (c *testConnector) Close() error                                 { return nil }
+func (c *testConnector) Begin() (driver.Tx, error)                    { return nil, errors.New("not supported") }
+
+func (c *testConnector) Prepare(query string) (driver.Stmt, error) {
+	c.query = query
+	return &testStmt{c}, nil
+}
+
+type testStmt struct {
+	c *testConnector
+}
+
+func (s *testStmt) Close() error  { return nil }
+func (s *testStmt) NumInput() int { return -1 }
+
+func (s *testStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+
+func (s *testStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &testRows{columns: s.c.columns, rows: s.c.rows}, nil
+}
+
+type testRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *testRows) Columns() []string { return r.columns }
+func (r *testRows) Close() error      { return nil }
+
+func (r *testRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+func TestLoadSource(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	assert.NoError(i.LoadSource(context.Background(),
+		NewMapSource(map[string]map[string]string{
+			"en-us": {"hello": "Hello", "bye": "Bye"},
+		}),
+		SourceFunc(func(ctx context.Context) ([]Entry, error) {
+			return []Entry{
+				{Locale: "en_US", Name: "bye", Text: "Goodbye", Metadata: map[string]string{"description": "On the sign out page"}},
+				{Locale: "zh-TW", Name: "hello", Text: "你好"},
+			}, nil
+		}),
+	))
+	assert.Equal("Hello", i.NewLocale("en-us").String("hello"))
+	assert.Equal("Goodbye", i.NewLocale("en-us").String("bye"))
+	assert.Equal("你好", i.NewLocale("zh-tw").String("hello"))
+	assert.Equal(map[string]string{"description": "On the sign out page"}, i.Metadata("en-us", "bye"))
+	assert.Nil(i.Metadata("en-us", "hello"))
+	assert.Nil(i.Metadata("ja", "hello"))
+
+	err := errors.New("unavailable")
+	assert.Equal(err, i.LoadSource(context.Background(), SourceFunc(func(ctx context.Context) ([]Entry, error) {
+		return nil, err
+	})))
+}
+
+func TestLoadWhileReading(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	assert.NoError(i.LoadMap(map[string]map[string]string{
+		"en-us": {"hello": "Hello", "Post <verb>": "Post it"},
+	}))
+	l := i.NewLocale("en-us")
+	compiled := i.Compile()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for ctx.Err() == nil {
+			l.String("hello")
+			l.StringX("Post", "verb")
+			i.Metadata("en-us", "hello")
+			i.NewLocale("en-xa").String("hello")
+		}
+	}()
+	for j := 0; j < 200; j++ {
+		assert.NoError(i.LoadMap(map[string]map[string]string{
+			"en-us": {"hello": "Hello", "Post <verb>": "Post it"},
+		}))
+		assert.NoError(i.LoadSource(context.Background(), NewMapSource(map[string]map[string]string{
+			"en-us": {"hello": "Hello", "Post <verb>": "Post it"},
+		})))
+		assert.NoError(i.LoadCompiled(compiled))
+		assert.NoError(i.LoadPseudo("en-xa"))
+	}
+	cancel()
+	<-done
+	assert.Equal("Post it", l.StringX("Post", "verb"))
+}
+
+func TestFSSource(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("zh-tw", WithFileNamespace())
+	assert.NoError(i.LoadSource(context.Background(), i.NewFSSource(testTranslationFS, "test/zh_tw.hello.json")))
+	assert.Equal("訊息 C", i.NewLocale("zh-tw").String("hello.message_c"))
+	assert.Equal(map[string]string{"path": "test/zh_tw.hello.json"}, i.Metadata("zh-tw", "hello.message_c"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(i.LoadSource(ctx, i.NewFSSource(testTranslationFS, "test/*.json")), context.Canceled)
+
+	// `LoadGlob` and `LoadFiles` record the paths too.
+	i = New("zh-tw")
+	assert.NoError(i.LoadGlob("test/zh-tw.json", "test/zh_TW.json"))
+	assert.Equal(map[string]string{"path": "test/zh-tw.json"}, i.Metadata("zh-tw", "message_a"))
+	assert.Equal(map[string]string{"path": "test/zh_TW.json"}, i.Metadata("zh-tw", "message_b"))
+}
+
+func TestSQLSource(t *testing.T) {
+	assert := assert.New(t)
+
+	c := &testConnector{
+		columns: []string{"locale", "name", "text", "description"},
+		rows: [][]driver.Value{
+			{"en-us", "hello", "Hello", "The greeting"},
+			{"en-us", "items", "1 item | {{ .Count }} items", nil},
+			{"zh-tw", "hello", "你好", nil},
+			{"zh-tw", "items", nil, nil},
+		},
+	}
+	db := sql.OpenDB(c)
+	defer db.Close()
+
+	i := New("en-us")
+	assert.NoError(i.LoadSource(context.Background(), NewSQLSource(db, "SELECT locale, name, text, description FROM translations")))
+	assert.Equal("SELECT locale, name, text, description FROM translations", c.query)
+
+	assert.Equal("Hello", i.NewLocale("en-us").String("hello"))
+	assert.Equal("3 items", i.NewLocale("en-us").Number("items", 3))
+	assert.Equal("你好", i.NewLocale("zh-tw").String("hello"))
+	assert.Equal("3 items", i.NewLocale("zh-tw").Number("items", 3))
+	assert.Equal(map[string]string{"description": "The greeting"}, i.Metadata("en-us", "hello"))
+	assert.Nil(i.Metadata("en-us", "items"))
+
+	c.columns = []string{"name", "text"}
+	assert.EqualError(i.LoadSource(context.Background(), NewSQLSource(db, "SELECT name, text FROM translations")), "the query should select the locale, the name and the text, got 2 columns")
+}
+
+func TestHTTPSource(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"en-us": {"hello": "Hello", "bye": {"text": "Bye", "description": "On the sign out page"}},
+			"zh-tw": {"hello": "你好"}
+		}`))
+	}))
+	defer ts.Close()
+
+	i := New("en-us")
+	assert.NoError(i.LoadSource(context.Background(), NewHTTPSource(ts.URL, WithHTTPClient(ts.Client()), WithHTTPHeader("Authorization", "Bearer token"))))
+	assert.Equal("Hello", i.NewLocale("en-us").String("hello"))
+	assert.Equal("Bye", i.NewLocale("en-us").String("bye"))
+	assert.Equal("你好", i.NewLocale("zh-tw").String("hello"))
+	assert.Equal(map[string]string{"description": "On the sign out page"}, i.Metadata("en-us", "bye"))
+	assert.Nil(i.Metadata("en-us", "hello"))
+
+	assert.EqualError(i.LoadSource(context.Background(), NewHTTPSource(ts.URL)), "GET "+ts.URL+": unexpected status 401 Unauthorized")
+}
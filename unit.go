@@ -0,0 +1,706 @@
+package i18n
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Width is the length of the unit names, such as `3 hours`, `3 hr` or `3h`.
+type Width int
+
+const (
+	// WidthLong spells the unit names out (e.g. `3 hours`).
+	WidthLong Width = iota
+	// WidthShort abbreviates the unit names (e.g. `3 hr`).
+	WidthShort
+	// WidthNarrow uses the shortest unit names (e.g. `3h`).
+	WidthNarrow
+)
+
+// widths maps the names that were used in the templates to the widths.
+var widths = map[string]Width{
+	"long":   WidthLong,
+	"short":  WidthShort,
+	"narrow": WidthNarrow,
+}
+
+// UnitFormat describes how a unit should be formatted.
+type UnitFormat struct {
+	width  Width
+	auto   bool
+	number []func(*NumberFormat)
+}
+
+// WithWidth changes the width of the unit names, defaults to `WidthLong`.
+func WithWidth(w Width) func(*UnitFormat) {
+	return func(f *UnitFormat) {
+		f.width = w
+	}
+}
+
+// WithNumberFormat changes how the number of the unit should be formatted (e.g. `WithFractionDigits(1, 1)`).
+func WithNumberFormat(options ...func(*NumberFormat)) func(*UnitFormat) {
+	return func(f *UnitFormat) {
+		f.number = append(f.number, options...)
+	}
+}
+
+// newUnitFormat
+func newUnitFormat(options ...func(*UnitFormat)) *UnitFormat {
+	f := &UnitFormat{}
+	for _, o := range options {
+		o(f)
+	}
+	return f
+}
+
+// FormatUnit formats the value with a CLDR unit, such as `kilometer`, `kilogram`, `gigabyte`, `hour`, `celsius` or `kilometer-per-hour`
+// (e.g. `1 kilometer`, `5 km`). The unit name is pluralized with the pluralizor of the locale, the fractions use the last plural form.
+func (l *Locale) FormatUnit(v any, unit string, options ...func(*UnitFormat)) string {
+	f := newUnitFormat(options...)
+	count, fraction := pluralOperand(v, f.number...)
+	forms := strings.Split(l.unitPattern(unit, f.width), " | ")
+	form := forms[len(forms)-1]
+	if !fraction {
+		form = forms[pluralIndex(l.parent.pluralizor(l.locale), count, len(forms))]
+	}
+	return strings.Replace(form, "{0}", l.FormatNumber(v, f.number...), 1)
+}
+
+// byteUnits are the digital storage units from the smallest to the largest.
+var byteUnits = []string{"byte", "kilobyte", "megabyte", "gigabyte", "terabyte", "petabyte"}
+
+// FormatByteSize formats the bytes with the largest fitting unit in the powers of 1000 and 1 fraction digit at most
+// (e.g. `3.2 GB`, `3,2 Go`), the unit names are short unless `WithWidth` was used.
+func (l *Locale) FormatByteSize(bytes int64, options ...func(*UnitFormat)) string {
+	v := float64(bytes)
+	unit := 0
+	for unit < len(byteUnits)-1 && math.Abs(v) >= 1000 {
+		v /= 1000
+		unit++
+	}
+	if math.Abs(v) >= 999.95 && unit < len(byteUnits)-1 {
+		v /= 1000
+		unit++
+	}
+	return l.FormatUnit(v, byteUnits[unit], append([]func(*UnitFormat){WithWidth(WidthShort), WithNumberFormat(WithFractionDigits(0, 1))}, options...)...)
+}
+
+// pluralOperand returns the integer for the pluralizor after the number was rounded,
+// and reports whether the number still has a fraction.
+func pluralOperand(v any, options ...func(*NumberFormat)) (int, bool) {
+	f := newNumberFormat(options...)
+	n, ok := parseDecimal(v)
+	if !ok || n.special != "" {
+		return 0, true
+	}
+	n = n.round(f.minFractionDigits, f.maxFractionDigits)
+	count, err := strconv.Atoi(n.integer)
+	if err != nil {
+		count = math.MaxInt
+	}
+	return count, strings.Trim(n.fraction, "0") != ""
+}
+
+// unitData is the unit patterns of a locale, a pattern contains the plural forms that were split by ` | `
+// and will be chosen by the pluralizor of the locale, like the translations.
+type unitData struct {
+	units [3]map[string]string
+	// separators join the units of a duration (e.g. `2 hours, 15 minutes`).
+	separators [3]string
+}
+
+// pattern returns the pattern of the unit, the narrower widths fall back to the wider ones if they are missing.
+func (u *unitData) pattern(unit string, width Width) (string, bool) {
+	for w := width; w >= WidthLong; w-- {
+		if v, ok := u.units[w][unit]; ok {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// symbolUnits creates the unit patterns with the international symbols (e.g. `{0} km`),
+// the symbols are separated from the number by `space`, and the overrides replace the patterns.
+func symbolUnits(space string, overrides map[string]string) map[string]string {
+	units := map[string]string{
+		"millimeter":         "mm",
+		"centimeter":         "cm",
+		"meter":              "m",
+		"kilometer":          "km",
+		"gram":               "g",
+		"kilogram":           "kg",
+		"bit":                "bit",
+		"byte":               "B",
+		"kilobyte":           "kB",
+		"megabyte":           "MB",
+		"gigabyte":           "GB",
+		"terabyte":           "TB",
+		"petabyte":           "PB",
+		"millisecond":        "ms",
+		"kilometer-per-hour": "km/h",
+		"meter-per-second":   "m/s",
+	}
+	for k, v := range units {
+		units[k] = "{0}" + space + v
+	}
+	for k, v := range overrides {
+		units[k] = v
+	}
+	return units
+}
+
+var unitDatas = map[string]*unitData{
+	"en": {
+		units: [3]map[string]string{
+			{
+				"millimeter":         "{0} millimeters | {0} millimeter | {0} millimeters",
+				"centimeter":         "{0} centimeters | {0} centimeter | {0} centimeters",
+				"meter":              "{0} meters | {0} meter | {0} meters",
+				"kilometer":          "{0} kilometers | {0} kilometer | {0} kilometers",
+				"inch":               "{0} inches | {0} inch | {0} inches",
+				"foot":               "{0} feet | {0} foot | {0} feet",
+				"yard":               "{0} yards | {0} yard | {0} yards",
+				"mile":               "{0} miles | {0} mile | {0} miles",
+				"gram":               "{0} grams | {0} gram | {0} grams",
+				"kilogram":           "{0} kilograms | {0} kilogram | {0} kilograms",
+				"ounce":              "{0} ounces | {0} ounce | {0} ounces",
+				"pound":              "{0} pounds | {0} pound | {0} pounds",
+				"bit":                "{0} bits | {0} bit | {0} bits",
+				"byte":               "{0} bytes | {0} byte | {0} bytes",
+				"kilobyte":           "{0} kilobytes | {0} kilobyte | {0} kilobytes",
+				"megabyte":           "{0} megabytes | {0} megabyte | {0} megabytes",
+				"gigabyte":           "{0} gigabytes | {0} gigabyte | {0} gigabytes",
+				"terabyte":           "{0} terabytes | {0} terabyte | {0} terabytes",
+				"petabyte":           "{0} petabytes | {0} petabyte | {0} petabytes",
+				"year":               "{0} years | {0} year | {0} years",
+				"month":              "{0} months | {0} month | {0} months",
+				"week":               "{0} weeks | {0} week | {0} weeks",
+				"day":                "{0} days | {0} day | {0} days",
+				"hour":               "{0} hours | {0} hour | {0} hours",
+				"minute":             "{0} minutes | {0} minute | {0} minutes",
+				"second":             "{0} seconds | {0} second | {0} seconds",
+				"millisecond":        "{0} milliseconds | {0} millisecond | {0} milliseconds",
+				"celsius":            "{0} degrees Celsius | {0} degree Celsius | {0} degrees Celsius",
+				"fahrenheit":         "{0} degrees Fahrenheit | {0} degree Fahrenheit | {0} degrees Fahrenheit",
+				"kilometer-per-hour": "{0} kilometers per hour | {0} kilometer per hour | {0} kilometers per hour",
+				"mile-per-hour":      "{0} miles per hour | {0} mile per hour | {0} miles per hour",
+				"meter-per-second":   "{0} meters per second | {0} meter per second | {0} meters per second",
+			},
+			symbolUnits(" ", map[string]string{
+				"inch":          "{0} in",
+				"foot":          "{0} ft",
+				"yard":          "{0} yd",
+				"mile":          "{0} mi",
+				"ounce":         "{0} oz",
+				"pound":         "{0} lb",
+				"byte":          "{0} byte",
+				"year":          "{0} yrs | {0} yr | {0} yrs",
+				"month":         "{0} mths | {0} mth | {0} mths",
+				"week":          "{0} wks | {0} wk | {0} wks",
+				"day":           "{0} days | {0} day | {0} days",
+				"hour":          "{0} hr",
+				"minute":        "{0} min",
+				"second":        "{0} sec",
+				"celsius":       "{0}°C",
+				"fahrenheit":    "{0}°F",
+				"mile-per-hour": "{0} mph",
+			}),
+			symbolUnits("", map[string]string{
+				"inch":          "{0}″",
+				"foot":          "{0}′",
+				"yard":          "{0}yd",
+				"mile":          "{0}mi",
+				"ounce":         "{0}oz",
+				"pound":         "{0}lb",
+				"year":          "{0}y",
+				"month":         "{0}m",
+				"week":          "{0}w",
+				"day":           "{0}d",
+				"hour":          "{0}h",
+				"minute":        "{0}m",
+				"second":        "{0}s",
+				"celsius":       "{0}°C",
+				"fahrenheit":    "{0}°",
+				"mile-per-hour": "{0}mph",
+			}),
+		},
+		separators: [3]string{", ", ", ", " "},
+	},
+	"zh": {
+		units: [3]map[string]string{
+			{
+				"millimeter":         "{0}毫米",
+				"centimeter":         "{0}厘米",
+				"meter":              "{0}米",
+				"kilometer":          "{0}公里",
+				"inch":               "{0}英寸",
+				"foot":               "{0}英尺",
+				"yard":               "{0}码",
+				"mile":               "{0}英里",
+				"gram":               "{0}克",
+				"kilogram":           "{0}千克",
+				"ounce":              "{0}盎司",
+				"pound":              "{0}磅",
+				"bit":                "{0}比特",
+				"byte":               "{0}字节",
+				"kilobyte":           "{0}千字节",
+				"megabyte":           "{0}兆字节",
+				"gigabyte":           "{0}吉字节",
+				"terabyte":           "{0}太字节",
+				"petabyte":           "{0}拍字节",
+				"year":               "{0}年",
+				"month":              "{0}个月",
+				"week":               "{0}周",
+				"day":                "{0}天",
+				"hour":               "{0}小时",
+				"minute":             "{0}分钟",
+				"second":             "{0}秒钟",
+				"millisecond":        "{0}毫秒",
+				"celsius":            "{0}摄氏度",
+				"fahrenheit":         "{0}华氏度",
+				"kilometer-per-hour": "每小时{0}公里",
+				"mile-per-hour":      "每小时{0}英里",
+				"meter-per-second":   "每秒{0}米",
+			},
+			symbolUnits("", map[string]string{
+				"millimeter":         "{0}毫米",
+				"centimeter":         "{0}厘米",
+				"meter":              "{0}米",
+				"kilometer":          "{0}公里",
+				"gram":               "{0}克",
+				"kilogram":           "{0}千克",
+				"bit":                "{0}比特",
+				"second":             "{0}秒",
+				"celsius":            "{0}°C",
+				"fahrenheit":         "{0}°F",
+				"kilometer-per-hour": "{0}公里/小时",
+				"mile-per-hour":      "{0}英里/小时",
+				"meter-per-second":   "{0}米/秒",
+			}),
+			{
+				"minute": "{0}分",
+			},
+		},
+		separators: [3]string{"", "", ""},
+	},
+	"zh-tw": {
+		units: [3]map[string]string{
+			{
+				"millimeter":         "{0} 公釐",
+				"centimeter":         "{0} 公分",
+				"meter":              "{0} 公尺",
+				"kilometer":          "{0} 公里",
+				"inch":               "{0} 英寸",
+				"foot":               "{0} 英尺",
+				"yard":               "{0} 碼",
+				"mile":               "{0} 英里",
+				"gram":               "{0} 克",
+				"kilogram":           "{0} 公斤",
+				"ounce":              "{0} 盎司",
+				"pound":              "{0} 磅",
+				"bit":                "{0} 位元",
+				"byte":               "{0} 位元組",
+				"kilobyte":           "{0} 千位元組",
+				"megabyte":           "{0} 百萬位元組",
+				"gigabyte":           "{0} 十億位元組",
+				"terabyte":           "{0} 兆位元組",
+				"petabyte":           "{0} 千兆位元組",
+				"year":               "{0} 年",
+				"month":              "{0} 個月",
+				"week":               "{0} 週",
+				"day":                "{0} 天",
+				"hour":               "{0} 小時",
+				"minute":             "{0} 分鐘",
+				"second":             "{0} 秒",
+				"millisecond":        "{0} 毫秒",
+				"celsius":            "攝氏 {0} 度",
+				"fahrenheit":         "華氏 {0} 度",
+				"kilometer-per-hour": "每小時 {0} 公里",
+				"mile-per-hour":      "每小時 {0} 英里",
+				"meter-per-second":   "每秒 {0} 公尺",
+			},
+			symbolUnits(" ", map[string]string{
+				"millimeter":         "{0} 公釐",
+				"centimeter":         "{0} 公分",
+				"meter":              "{0} 公尺",
+				"kilometer":          "{0} 公里",
+				"gram":               "{0} 克",
+				"kilogram":           "{0} 公斤",
+				"bit":                "{0} 位元",
+				"byte":               "{0} byte",
+				"millisecond":        "{0} 毫秒",
+				"celsius":            "{0}°C",
+				"fahrenheit":         "{0}°F",
+				"kilometer-per-hour": "{0} 公里/小時",
+				"mile-per-hour":      "{0} 英里/小時",
+				"meter-per-second":   "{0} 公尺/秒",
+			}),
+			{
+				"day":         "{0}天",
+				"hour":        "{0}小時",
+				"minute":      "{0}分",
+				"second":      "{0}秒",
+				"millisecond": "{0}毫秒",
+			},
+		},
+		separators: [3]string{" ", " ", ""},
+	},
+	"ja": {
+		units: [3]map[string]string{
+			{
+				"millimeter":         "{0} ミリメートル",
+				"centimeter":         "{0} センチメートル",
+				"meter":              "{0} メートル",
+				"kilometer":          "{0} キロメートル",
+				"inch":               "{0} インチ",
+				"foot":               "{0} フィート",
+				"yard":               "{0} ヤード",
+				"mile":               "{0} マイル",
+				"gram":               "{0} グラム",
+				"kilogram":           "{0} キログラム",
+				"ounce":              "{0} オンス",
+				"pound":              "{0} ポンド",
+				"bit":                "{0} ビット",
+				"byte":               "{0} バイト",
+				"kilobyte":           "{0} キロバイト",
+				"megabyte":           "{0} メガバイト",
+				"gigabyte":           "{0} ギガバイト",
+				"terabyte":           "{0} テラバイト",
+				"petabyte":           "{0} ペタバイト",
+				"year":               "{0} 年",
+				"month":              "{0} か月",
+				"week":               "{0} 週間",
+				"day":                "{0} 日",
+				"hour":               "{0} 時間",
+				"minute":             "{0} 分",
+				"second":             "{0} 秒",
+				"millisecond":        "{0} ミリ秒",
+				"celsius":            "摂氏 {0} 度",
+				"fahrenheit":         "華氏 {0} 度",
+				"kilometer-per-hour": "時速 {0} キロメートル",
+				"mile-per-hour":      "時速 {0} マイル",
+				"meter-per-second":   "秒速 {0} メートル",
+			},
+			symbolUnits(" ", map[string]string{
+				"inch":          "{0} in",
+				"foot":          "{0} ft",
+				"yard":          "{0} yd",
+				"mile":          "{0} マイル",
+				"ounce":         "{0} oz",
+				"pound":         "{0} lb",
+				"byte":          "{0} byte",
+				"celsius":       "{0}°C",
+				"fahrenheit":    "{0}°F",
+				"mile-per-hour": "{0} mph",
+			}),
+			{
+				"day":         "{0}日",
+				"hour":        "{0}時間",
+				"minute":      "{0}分",
+				"second":      "{0}秒",
+				"millisecond": "{0}ms",
+			},
+		},
+		separators: [3]string{" ", " ", ""},
+	},
+	"ko": {
+		units: [3]map[string]string{
+			{
+				"millimeter":         "{0}밀리미터",
+				"centimeter":         "{0}센티미터",
+				"meter":              "{0}미터",
+				"kilometer":          "{0}킬로미터",
+				"inch":               "{0}인치",
+				"foot":               "{0}피트",
+				"yard":               "{0}야드",
+				"mile":               "{0}마일",
+				"gram":               "{0}그램",
+				"kilogram":           "{0}킬로그램",
+				"ounce":              "{0}온스",
+				"pound":              "{0}파운드",
+				"bit":                "{0}비트",
+				"byte":               "{0}바이트",
+				"kilobyte":           "{0}킬로바이트",
+				"megabyte":           "{0}메가바이트",
+				"gigabyte":           "{0}기가바이트",
+				"terabyte":           "{0}테라바이트",
+				"petabyte":           "{0}페타바이트",
+				"year":               "{0}년",
+				"month":              "{0}개월",
+				"week":               "{0}주",
+				"day":                "{0}일",
+				"hour":               "{0}시간",
+				"minute":             "{0}분",
+				"second":             "{0}초",
+				"millisecond":        "{0}밀리초",
+				"celsius":            "섭씨 {0}도",
+				"fahrenheit":         "화씨 {0}도",
+				"kilometer-per-hour": "시속 {0}킬로미터",
+				"mile-per-hour":      "시속 {0}마일",
+				"meter-per-second":   "초속 {0}미터",
+			},
+			symbolUnits("", map[string]string{
+				"inch":          "{0}in",
+				"foot":          "{0}ft",
+				"yard":          "{0}yd",
+				"mile":          "{0}mi",
+				"ounce":         "{0}oz",
+				"pound":         "{0}lb",
+				"byte":          "{0}byte",
+				"celsius":       "{0}°C",
+				"fahrenheit":    "{0}°F",
+				"mile-per-hour": "{0}mph",
+			}),
+			{},
+		},
+		separators: [3]string{" ", " ", " "},
+	},
+	"de": {
+		units: [3]map[string]string{
+			{
+				"millimeter":         "{0} Millimeter",
+				"centimeter":         "{0} Zentimeter",
+				"meter":              "{0} Meter",
+				"kilometer":          "{0} Kilometer",
+				"inch":               "{0} Zoll",
+				"foot":               "{0} Fuß",
+				"yard":               "{0} Yards | {0} Yard | {0} Yards",
+				"mile":               "{0} Meilen | {0} Meile | {0} Meilen",
+				"gram":               "{0} Gramm",
+				"kilogram":           "{0} Kilogramm",
+				"ounce":              "{0} Unzen | {0} Unze | {0} Unzen",
+				"pound":              "{0} Pfund",
+				"bit":                "{0} Bit",
+				"byte":               "{0} Byte",
+				"kilobyte":           "{0} Kilobyte",
+				"megabyte":           "{0} Megabyte",
+				"gigabyte":           "{0} Gigabyte",
+				"terabyte":           "{0} Terabyte",
+				"petabyte":           "{0} Petabyte",
+				"year":               "{0} Jahre | {0} Jahr | {0} Jahre",
+				"month":              "{0} Monate | {0} Monat | {0} Monate",
+				"week":               "{0} Wochen | {0} Woche | {0} Wochen",
+				"day":                "{0} Tage | {0} Tag | {0} Tage",
+				"hour":               "{0} Stunden | {0} Stunde | {0} Stunden",
+				"minute":             "{0} Minuten | {0} Minute | {0} Minuten",
+				"second":             "{0} Sekunden | {0} Sekunde | {0} Sekunden",
+				"millisecond":        "{0} Millisekunden | {0} Millisekunde | {0} Millisekunden",
+				"celsius":            "{0} Grad Celsius",
+				"fahrenheit":         "{0} Grad Fahrenheit",
+				"kilometer-per-hour": "{0} Kilometer pro Stunde",
+				"mile-per-hour":      "{0} Meilen pro Stunde | {0} Meile pro Stunde | {0} Meilen pro Stunde",
+				"meter-per-second":   "{0} Meter pro Sekunde",
+			},
+			symbolUnits(" ", map[string]string{
+				"inch":          "{0} Zoll",
+				"foot":          "{0} Fuß",
+				"yard":          "{0} yd",
+				"mile":          "{0} mi",
+				"ounce":         "{0} oz",
+				"pound":         "{0} lb",
+				"byte":          "{0} Byte",
+				"year":          "{0} J.",
+				"month":         "{0} Mon.",
+				"week":          "{0} Wo.",
+				"day":           "{0} Tg.",
+				"hour":          "{0} Std.",
+				"minute":        "{0} Min.",
+				"second":        "{0} Sek.",
+				"celsius":       "{0} °C",
+				"fahrenheit":    "{0} °F",
+				"mile-per-hour": "{0} mi/h",
+			}),
+			{
+				"year":   "{0} J",
+				"month":  "{0} M",
+				"week":   "{0} W",
+				"day":    "{0} T",
+				"second": "{0} s",
+			},
+		},
+		separators: [3]string{", ", ", ", " "},
+	},
+	"fr": {
+		units: [3]map[string]string{
+			{
+				"millimeter":         "{0} millimètre | {0} millimètres",
+				"centimeter":         "{0} centimètre | {0} centimètres",
+				"meter":              "{0} mètre | {0} mètres",
+				"kilometer":          "{0} kilomètre | {0} kilomètres",
+				"inch":               "{0} pouce | {0} pouces",
+				"foot":               "{0} pied | {0} pieds",
+				"yard":               "{0} yard | {0} yards",
+				"mile":               "{0} mile | {0} miles",
+				"gram":               "{0} gramme | {0} grammes",
+				"kilogram":           "{0} kilogramme | {0} kilogrammes",
+				"ounce":              "{0} once | {0} onces",
+				"pound":              "{0} livre | {0} livres",
+				"bit":                "{0} bit | {0} bits",
+				"byte":               "{0} octet | {0} octets",
+				"kilobyte":           "{0} kilooctet | {0} kilooctets",
+				"megabyte":           "{0} mégaoctet | {0} mégaoctets",
+				"gigabyte":           "{0} gigaoctet | {0} gigaoctets",
+				"terabyte":           "{0} téraoctet | {0} téraoctets",
+				"petabyte":           "{0} pétaoctet | {0} pétaoctets",
+				"year":               "{0} an | {0} ans",
+				"month":              "{0} mois",
+				"week":               "{0} semaine | {0} semaines",
+				"day":                "{0} jour | {0} jours",
+				"hour":               "{0} heure | {0} heures",
+				"minute":             "{0} minute | {0} minutes",
+				"second":             "{0} seconde | {0} secondes",
+				"millisecond":        "{0} milliseconde | {0} millisecondes",
+				"celsius":            "{0} degré Celsius | {0} degrés Celsius",
+				"fahrenheit":         "{0} degré Fahrenheit | {0} degrés Fahrenheit",
+				"kilometer-per-hour": "{0} kilomètre par heure | {0} kilomètres par heure",
+				"mile-per-hour":      "{0} mile par heure | {0} miles par heure",
+				"meter-per-second":   "{0} mètre par seconde | {0} mètres par seconde",
+			},
+			symbolUnits(" ", map[string]string{
+				"inch":          "{0} po",
+				"foot":          "{0} pi",
+				"yard":          "{0} yd",
+				"mile":          "{0} mi",
+				"ounce":         "{0} oz",
+				"pound":         "{0} lb",
+				"byte":          "{0} o",
+				"kilobyte":      "{0} ko",
+				"megabyte":      "{0} Mo",
+				"gigabyte":      "{0} Go",
+				"terabyte":      "{0} To",
+				"petabyte":      "{0} Po",
+				"year":          "{0} a",
+				"month":         "{0} m.",
+				"week":          "{0} sem.",
+				"day":           "{0} j",
+				"hour":          "{0} h",
+				"minute":        "{0} min",
+				"second":        "{0} s",
+				"celsius":       "{0} °C",
+				"fahrenheit":    "{0} °F",
+				"mile-per-hour": "{0} mi/h",
+			}),
+			symbolUnits("", map[string]string{
+				"inch":          "{0}″",
+				"foot":          "{0}′",
+				"yard":          "{0}yd",
+				"mile":          "{0}mi",
+				"ounce":         "{0}oz",
+				"pound":         "{0}lb",
+				"byte":          "{0}o",
+				"kilobyte":      "{0}ko",
+				"megabyte":      "{0}Mo",
+				"gigabyte":      "{0}Go",
+				"terabyte":      "{0}To",
+				"petabyte":      "{0}Po",
+				"year":          "{0}a",
+				"month":         "{0}m.",
+				"week":          "{0}sem.",
+				"day":           "{0}j",
+				"hour":          "{0}h",
+				"minute":        "{0}min",
+				"second":        "{0}s",
+				"celsius":       "{0}°C",
+				"fahrenheit":    "{0}°F",
+				"mile-per-hour": "{0}mi/h",
+			}),
+		},
+		separators: [3]string{", ", " ", " "},
+	},
+	"es": {
+		units: [3]map[string]string{
+			{
+				"millimeter":         "{0} milímetros | {0} milímetro | {0} milímetros",
+				"centimeter":         "{0} centímetros | {0} centímetro | {0} centímetros",
+				"meter":              "{0} metros | {0} metro | {0} metros",
+				"kilometer":          "{0} kilómetros | {0} kilómetro | {0} kilómetros",
+				"inch":               "{0} pulgadas | {0} pulgada | {0} pulgadas",
+				"foot":               "{0} pies | {0} pie | {0} pies",
+				"yard":               "{0} yardas | {0} yarda | {0} yardas",
+				"mile":               "{0} millas | {0} milla | {0} millas",
+				"gram":               "{0} gramos | {0} gramo | {0} gramos",
+				"kilogram":           "{0} kilogramos | {0} kilogramo | {0} kilogramos",
+				"ounce":              "{0} onzas | {0} onza | {0} onzas",
+				"pound":              "{0} libras | {0} libra | {0} libras",
+				"bit":                "{0} bits | {0} bit | {0} bits",
+				"byte":               "{0} bytes | {0} byte | {0} bytes",
+				"kilobyte":           "{0} kilobytes | {0} kilobyte | {0} kilobytes",
+				"megabyte":           "{0} megabytes | {0} megabyte | {0} megabytes",
+				"gigabyte":           "{0} gigabytes | {0} gigabyte | {0} gigabytes",
+				"terabyte":           "{0} terabytes | {0} terabyte | {0} terabytes",
+				"petabyte":           "{0} petabytes | {0} petabyte | {0} petabytes",
+				"year":               "{0} años | {0} año | {0} años",
+				"month":              "{0} meses | {0} mes | {0} meses",
+				"week":               "{0} semanas | {0} semana | {0} semanas",
+				"day":                "{0} días | {0} día | {0} días",
+				"hour":               "{0} horas | {0} hora | {0} horas",
+				"minute":             "{0} minutos | {0} minuto | {0} minutos",
+				"second":             "{0} segundos | {0} segundo | {0} segundos",
+				"millisecond":        "{0} milisegundos | {0} milisegundo | {0} milisegundos",
+				"celsius":            "{0} grados Celsius | {0} grado Celsius | {0} grados Celsius",
+				"fahrenheit":         "{0} grados Fahrenheit | {0} grado Fahrenheit | {0} grados Fahrenheit",
+				"kilometer-per-hour": "{0} kilómetros por hora | {0} kilómetro por hora | {0} kilómetros por hora",
+				"mile-per-hour":      "{0} millas por hora | {0} milla por hora | {0} millas por hora",
+				"meter-per-second":   "{0} metros por segundo | {0} metro por segundo | {0} metros por segundo",
+			},
+			symbolUnits(" ", map[string]string{
+				"inch":          "{0} in",
+				"foot":          "{0} ft",
+				"yard":          "{0} yd",
+				"mile":          "{0} mi",
+				"ounce":         "{0} oz",
+				"pound":         "{0} lb",
+				"year":          "{0} a",
+				"month":         "{0} m.",
+				"week":          "{0} sem.",
+				"day":           "{0} d",
+				"hour":          "{0} h",
+				"minute":        "{0} min",
+				"second":        "{0} s",
+				"celsius":       "{0} °C",
+				"fahrenheit":    "{0} °F",
+				"mile-per-hour": "{0} mi/h",
+			}),
+			{},
+		},
+		separators: [3]string{", ", ", ", " "},
+	},
+}
+
+func init() {
+	unitDatas["zh-hk"] = unitDatas["zh-tw"]
+}
+
+// unitPattern returns the pattern of the unit in the locale, or in English if the locale doesn't have the unit.
+func (l *Locale) unitPattern(unit string, width Width) string {
+	if v, ok := lookupLocale(unitDatas, l.locale).pattern(unit, width); ok {
+		return v
+	}
+	if v, ok := unitDatas["en"].pattern(unit, width); ok {
+		return v
+	}
+	return "{0} " + unit
+}
+
+// plural chooses the plural form of the pattern with the pluralizor of the locale, and replaces `{0}` with the number.
+func (l *Locale) plural(pattern string, count int, number string) string {
+	forms := strings.Split(pattern, " | ")
+	if count < 0 {
+		count = -count
+	}
+	return strings.Replace(forms[pluralIndex(l.parent.pluralizor(l.locale), count, len(forms))], "{0}", number, 1)
+}
+
+// pluralIndex calls the pluralizor and keeps the index in the range of the choices.
+func pluralIndex(p Pluralizor, count, choices int) int {
+	index := p(count, choices)
+	if index < 0 {
+		return 0
+	}
+	if index >= choices {
+		return choices - 1
+	}
+	return index
+}
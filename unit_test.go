@@ -0,0 +1,95 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatUnit(t *testing.T) {
+	assert := assert.New(t)
+
+	en := newFormatLocale("en-us")
+	tw := newFormatLocale("zh-tw")
+	de := newFormatLocale("de-de")
+	fr := newFormatLocale("fr-fr")
+
+	assert.Equal("1 kilometer", en.FormatUnit(1, "kilometer"))
+	assert.Equal("5 kilometers", en.FormatUnit(5, "kilometer"))
+	assert.Equal("1.5 kilometers", en.FormatUnit(1.5, "kilometer"))
+	assert.Equal("1 kilometer", en.FormatUnit(1.0004, "kilometer"))
+	assert.Equal("5 km", en.FormatUnit(5, "kilometer", WithWidth(WidthShort)))
+	assert.Equal("5km", en.FormatUnit(5, "kilometer", WithWidth(WidthNarrow)))
+	assert.Equal("1,200 feet", en.FormatUnit(1200, "foot"))
+	assert.Equal("3 pounds", en.FormatUnit(3, "pound"))
+	assert.Equal("20°C", en.FormatUnit(20, "celsius", WithWidth(WidthShort)))
+	assert.Equal("-5 degrees Celsius", en.FormatUnit(-5, "celsius"))
+	assert.Equal("60 mph", en.FormatUnit(60, "mile-per-hour", WithWidth(WidthShort)))
+	assert.Equal("1 Kilometer", de.FormatUnit(1, "kilometer"))
+	assert.Equal("1 Meile", de.FormatUnit(1, "mile"))
+	assert.Equal("2,5 Stunden", de.FormatUnit(2.5, "hour"))
+	assert.Equal("1 octet", fr.FormatUnit(1, "byte"))
+	assert.Equal("1,5 kilomètres", fr.FormatUnit(1.5, "kilometer"))
+	assert.Equal("0 kilomètre", fr.FormatUnit(0, "kilometer"))
+	assert.Equal("攝氏 20 度", tw.FormatUnit(20, "celsius"))
+	assert.Equal("每小時 60 公里", tw.FormatUnit(60, "kilometer-per-hour"))
+
+	// Number formats.
+	assert.Equal("1.50 kilograms", en.FormatUnit(1.5, "kilogram", WithNumberFormat(WithFractionDigits(2, 2))))
+	assert.Equal("2 kilograms", en.FormatUnit(1.5, "kilogram", WithNumberFormat(WithFractionDigits(0, 0))))
+
+	// Unknown units.
+	assert.Equal("3 furlong", en.FormatUnit(3, "furlong"))
+}
+
+func TestFormatUnitPluralizor(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us", WithPluralizor(map[string]Pluralizor{
+		"en-us": func(number, choices int) int {
+			return 0
+		},
+	}))
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{},
+	})
+	assert.Equal("5 kilometers", i.NewLocale("en-us").FormatUnit(5, "kilometer"))
+	assert.Equal("1 kilometers", i.NewLocale("en-us").FormatUnit(1, "kilometer"))
+}
+
+func TestFormatByteSize(t *testing.T) {
+	assert := assert.New(t)
+
+	en := newFormatLocale("en-us")
+	fr := newFormatLocale("fr-fr")
+
+	assert.Equal("512 byte", en.FormatByteSize(512))
+	assert.Equal("1.5 kB", en.FormatByteSize(1500))
+	assert.Equal("3.2 GB", en.FormatByteSize(3200000000))
+	assert.Equal("1 MB", en.FormatByteSize(999960))
+	assert.Equal("3,2 Go", fr.FormatByteSize(3200000000))
+	assert.Equal("3.2 gigabytes", en.FormatByteSize(3200000000, WithWidth(WidthLong)))
+	assert.Equal("1,200 PB", en.FormatByteSize(1200000000000000000))
+}
+
+func TestUnitTemplateFuncs(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	i.LoadMap(map[string]map[string]string{
+		"en-us": map[string]string{
+			"test_distance": "{{ unit .Distance \"kilometer\" }} ({{ unit .Distance \"kilometer\" \"short\" }}) away",
+			"test_quota":    "{{ bytes .Used }} used",
+		},
+		"fr-fr": map[string]string{
+			"test_quota": "{{ bytes .Used }} utilisés",
+		},
+	})
+	data := map[string]any{
+		"Distance": 5,
+		"Used":     3200000000,
+	}
+	assert.Equal("5 kilometers (5 km) away", i.NewLocale("en-us").String("test_distance", data))
+	assert.Equal("3.2 GB used", i.NewLocale("en-us").String("test_quota", data))
+	assert.Equal("3,2 Go utilisés", i.NewLocale("fr-fr").String("test_quota", data))
+}
@@ -0,0 +1,508 @@
+package i18n
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// WithExtUnmarshaler registers the unmarshaler for the files with the extension (e.g. `.xml`),
+// `.json`, `.yaml`, `.yml`, `.toml`, `.po` and `.properties` are supported by default.
+func WithExtUnmarshaler(ext string, u Unmarshaler) func(*I18n) {
+	return func(i *I18n) {
+		i.unmarshalers[strings.ToLower(ext)] = u
+	}
+}
+
+// defaultUnmarshalers
+func defaultUnmarshalers() map[string]Unmarshaler {
+	return map[string]Unmarshaler{
+		".json":       json.Unmarshal,
+		".yaml":       yaml.Unmarshal,
+		".yml":        yaml.Unmarshal,
+		".toml":       UnmarshalTOML,
+		".po":         UnmarshalPO,
+		".properties": UnmarshalProperties,
+	}
+}
+
+// unmarshal unmarshals the file with the unmarshaler of its extension, or the one that was set by `WithUnmarshaler`.
+func (i *I18n) unmarshal(filename string, data []byte, v *map[string]string) error {
+	if i.unmarshaler != nil {
+		return i.unmarshaler(data, v)
+	}
+	ext := strings.ToLower(filepath.Ext(filename))
+	u, ok := i.unmarshalers[ext]
+	if !ok {
+		return fmt.Errorf("%s: no unmarshaler for the %q extension, register one with WithExtUnmarshaler", filename, ext)
+	}
+	if err := u(data, v); err != nil {
+		return fmt.Errorf("%s: %w", filename, err)
+	}
+	return nil
+}
+
+// translationMap returns the map that the unmarshalers write to.
+func translationMap(v any) (map[string]string, error) {
+	m, ok := v.(*map[string]string)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type %T, it should be *map[string]string", v)
+	}
+	if *m == nil {
+		*m = make(map[string]string)
+	}
+	return *m, nil
+}
+
+// UnmarshalProperties unmarshals the Java `.properties` files, the values can be separated by `=`, `:` or spaces,
+// the lines that start with `#` or `!` are comments and the lines that end with `\` are continued.
+func UnmarshalProperties(data []byte, v any) error {
+	m, err := translationMap(v)
+	if err != nil {
+		return err
+	}
+	var (
+		logical strings.Builder
+		n       int
+	)
+	add := func() error {
+		key, value := splitProperty(logical.String())
+		logical.Reset()
+		k, err := unescapeProperty(key)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", n, err)
+		}
+		val, err := unescapeProperty(value)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", n, err)
+		}
+		m[k] = val
+		return nil
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		n++
+		line := strings.TrimLeft(strings.TrimSuffix(scanner.Text(), "\r"), " \t\f")
+		if logical.Len() == 0 && (line == "" || line[0] == '#' || line[0] == '!') {
+			continue
+		}
+		// An odd number of the backslashes at the end continues the line.
+		if (len(line)-len(strings.TrimRight(line, `\`)))%2 == 1 {
+			logical.WriteString(line[:len(line)-1])
+			continue
+		}
+		logical.WriteString(line)
+		if err := add(); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if logical.Len() > 0 {
+		return add()
+	}
+	return nil
+}
+
+// splitProperty splits the line at the first unescaped `=`, `:` or space.
+func splitProperty(line string) (key, value string) {
+	for j := 0; j < len(line); j++ {
+		switch line[j] {
+		case '\\':
+			j++
+		case '=', ':':
+			return line[:j], strings.TrimLeft(line[j+1:], " \t\f")
+		case ' ', '\t', '\f':
+			value = strings.TrimLeft(line[j:], " \t\f")
+			if value != "" && (value[0] == '=' || value[0] == ':') {
+				value = strings.TrimLeft(value[1:], " \t\f")
+			}
+			return line[:j], value
+		}
+	}
+	return line, ""
+}
+
+// unescapeProperty
+func unescapeProperty(v string) (string, error) {
+	if !strings.Contains(v, `\`) {
+		return v, nil
+	}
+	var b strings.Builder
+	for j := 0; j < len(v); j++ {
+		if v[j] != '\\' || j+1 == len(v) {
+			b.WriteByte(v[j])
+			continue
+		}
+		j++
+		switch v[j] {
+		case 't':
+			b.WriteByte('\t')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 'f':
+			b.WriteByte('\f')
+		case 'u':
+			if j+5 > len(v) {
+				return "", fmt.Errorf("invalid unicode escape %q", v[j-1:])
+			}
+			r, err := strconv.ParseUint(v[j+1:j+5], 16, 32)
+			if err != nil {
+				return "", fmt.Errorf("invalid unicode escape %q", v[j-1:j+5])
+			}
+			j += 4
+			// The surrogate pairs are decoded with the next escape.
+			if utf16Surrogate(rune(r)) && j+7 <= len(v) && strings.HasPrefix(v[j+1:], `\u`) {
+				if r2, err := strconv.ParseUint(v[j+3:j+7], 16, 32); err == nil {
+					b.WriteRune(decodeSurrogates(rune(r), rune(r2)))
+					j += 6
+					continue
+				}
+			}
+			b.WriteRune(rune(r))
+		default:
+			b.WriteByte(v[j])
+		}
+	}
+	return b.String(), nil
+}
+
+// utf16Surrogate
+func utf16Surrogate(r rune) bool {
+	return r >= 0xd800 && r < 0xdc00
+}
+
+// decodeSurrogates
+func decodeSurrogates(r1, r2 rune) rune {
+	if r2 < 0xdc00 || r2 >= 0xe000 {
+		return utf8.RuneError
+	}
+	return (r1-0xd800)<<10 | (r2 - 0xdc00) + 0x10000
+}
+
+// UnmarshalPO unmarshals the gettext `.po` files, `msgid` is the name and `msgctxt` is the context (e.g. `Post <verb>`).
+// The plural forms (`msgstr[0]`, `msgstr[1]`...) are converted by the `Plural-Forms` header for the default pluralizor and joined by ` | `,
+// the strings are escaped so they're output literally. The untranslated and the fuzzy entries are skipped.
+func UnmarshalPO(data []byte, v any) error {
+	m, err := translationMap(v)
+	if err != nil {
+		return err
+	}
+	var (
+		context, id *string
+		strs        []string
+		fuzzy       bool
+		plural      bool
+		current     *string
+		// pluralForms is the `plural` expression of the header, defaults to the one of gettext.
+		pluralForms = "n!=1"
+	)
+	// flush adds the entry if its `msgstr` were read.
+	flush := func() {
+		if len(strs) == 0 {
+			return
+		}
+		switch {
+		case *id == "" && context == nil:
+			if v := poPluralForms(strs[0]); v != "" {
+				pluralForms = v
+			}
+		case *id != "" && !fuzzy && strings.Join(strs, "") != "":
+			name := *id
+			if context != nil {
+				name = fmt.Sprintf("%s <%s>", name, *context)
+			}
+			if plural {
+				strs = convertPOPlurals(pluralForms, strs)
+			}
+			// The strings are literal, so ` | `, `@:` and the others are escaped before the forms are joined.
+			for j, v := range strs {
+				strs[j] = escapeText(v)
+			}
+			m[name] = strings.Join(strs, " | ")
+		}
+		context, id, strs, fuzzy, plural, current = nil, nil, nil, false, false, nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			flush()
+			continue
+		case strings.HasPrefix(line, "#"):
+			flush()
+			if strings.HasPrefix(line, "#,") && strings.Contains(line, "fuzzy") {
+				fuzzy = true
+			}
+			continue
+		case strings.HasPrefix(line, `"`):
+			if current == nil {
+				return fmt.Errorf("line %d: unexpected string", n)
+			}
+			s, err := strconv.Unquote(line)
+			if err != nil {
+				return fmt.Errorf("line %d: %w", n, err)
+			}
+			*current += s
+			continue
+		}
+
+		keyword, value, _ := strings.Cut(line, " ")
+		s, err := strconv.Unquote(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("line %d: %w", n, err)
+		}
+		switch {
+		case keyword == "msgctxt":
+			flush()
+			context, current = &s, &s
+		case keyword == "msgid":
+			flush()
+			id, current = &s, &s
+		case keyword == "msgid_plural":
+			plural, current = true, &s
+		case keyword == "msgstr" || strings.HasPrefix(keyword, "msgstr["):
+			if id == nil {
+				return fmt.Errorf("line %d: msgstr without msgid", n)
+			}
+			strs = append(strs, s)
+			current = &strs[len(strs)-1]
+		default:
+			return fmt.Errorf("line %d: unknown keyword %q", n, keyword)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	flush()
+	return nil
+}
+
+// poPluralForms returns the `plural` expression in the `Plural-Forms` of the header without the spaces and the parentheses,
+// such as `n!=1` of `Plural-Forms: nplurals=2; plural=(n != 1);`.
+func poPluralForms(header string) string {
+	for _, line := range strings.Split(header, "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok || !strings.EqualFold(strings.TrimSpace(key), "Plural-Forms") {
+			continue
+		}
+		for _, v := range strings.Split(value, ";") {
+			k, expr, ok := strings.Cut(strings.TrimSpace(v), "=")
+			if ok && strings.TrimSpace(k) == "plural" {
+				return strings.NewReplacer(" ", "", "(", "", ")", "").Replace(expr)
+			}
+		}
+	}
+	return ""
+}
+
+// convertPOPlurals converts the `msgstr[n]` to the plural forms of the default pluralizor, which picks the first of two forms
+// for 0 and 1, and `zero | one | other` for three forms. The forms of the other expressions are kept in order,
+// use `WithPluralizor` to choose them like the expression.
+func convertPOPlurals(expr string, strs []string) []string {
+	switch {
+	case expr == "0":
+		// `nplurals=1`, such as Chinese and Japanese.
+		return strs[:1]
+	case expr == "n!=1" && len(strs) == 2:
+		// English, 0 is plural.
+		return []string{strs[1], strs[0], strs[1]}
+	}
+	return strs
+}
+
+// UnmarshalTOML unmarshals the TOML files that contain the strings only, the tables (e.g. `[checkout]`)
+// and the dotted keys are flattened as the names like `checkout.title`.
+func UnmarshalTOML(data []byte, v any) error {
+	m, err := translationMap(v)
+	if err != nil {
+		return err
+	}
+	var table string
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+	for n := 1; text != ""; n++ {
+		var line string
+		line, text, _ = strings.Cut(text, "\n")
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		if line[0] == '[' {
+			end := strings.LastIndexByte(line, ']')
+			if end == -1 || strings.HasPrefix(line, "[[") {
+				return fmt.Errorf("line %d: invalid table %q", n, line)
+			}
+			keys, rest, err := tomlKeys(line[1:end])
+			if err != nil || strings.TrimSpace(rest) != "" {
+				return fmt.Errorf("line %d: invalid table %q", n, line)
+			}
+			if comment := strings.TrimSpace(line[end+1:]); comment != "" && comment[0] != '#' {
+				return fmt.Errorf("line %d: invalid table %q", n, line)
+			}
+			table = strings.Join(keys, ".") + "."
+			continue
+		}
+
+		keys, rest, err := tomlKeys(line)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", n, err)
+		}
+		rest = strings.TrimSpace(rest)
+		if !strings.HasPrefix(rest, "=") {
+			return fmt.Errorf("line %d: expected `=` after the key", n)
+		}
+		rest = strings.TrimSpace(rest[1:])
+
+		var value string
+		switch {
+		case strings.HasPrefix(rest, `"""`), strings.HasPrefix(rest, `'''`):
+			delimiter := rest[:3]
+			body := rest[3:] + "\n" + text
+			if strings.HasPrefix(body, "\n") {
+				body = body[1:]
+			}
+			end := strings.Index(body, delimiter)
+			if end == -1 {
+				return fmt.Errorf("line %d: unterminated multi-line string", n)
+			}
+			value = body[:end]
+			remaining := body[end+3:]
+			n += strings.Count(body[:end], "\n")
+			rest, text, _ = strings.Cut(remaining, "\n")
+			if delimiter == `"""` {
+				if value, err = unquoteTOML(value, true); err != nil {
+					return fmt.Errorf("line %d: %w", n, err)
+				}
+			}
+		case strings.HasPrefix(rest, `"`), strings.HasPrefix(rest, `'`):
+			var end int
+			value, end, err = tomlString(rest)
+			if err != nil {
+				return fmt.Errorf("line %d: %w", n, err)
+			}
+			rest = rest[end:]
+		default:
+			return fmt.Errorf("line %d: only the strings are supported", n)
+		}
+		if comment := strings.TrimSpace(rest); comment != "" && comment[0] != '#' {
+			return fmt.Errorf("line %d: unexpected %q after the value", n, comment)
+		}
+		m[table+strings.Join(keys, ".")] = value
+	}
+	return nil
+}
+
+// tomlKeys parses the bare, quoted and dotted keys, and returns the rest of the line.
+func tomlKeys(line string) ([]string, string, error) {
+	var keys []string
+	for {
+		line = strings.TrimLeft(line, " \t")
+		switch {
+		case strings.HasPrefix(line, `"`), strings.HasPrefix(line, `'`):
+			key, end, err := tomlString(line)
+			if err != nil {
+				return nil, "", err
+			}
+			keys = append(keys, key)
+			line = line[end:]
+		default:
+			end := strings.IndexFunc(line, func(r rune) bool {
+				return !(r == '_' || r == '-' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9'))
+			})
+			if end == -1 {
+				end = len(line)
+			}
+			if end == 0 {
+				return nil, "", fmt.Errorf("invalid key %q", line)
+			}
+			keys = append(keys, line[:end])
+			line = line[end:]
+		}
+		trimmed := strings.TrimLeft(line, " \t")
+		if !strings.HasPrefix(trimmed, ".") {
+			return keys, line, nil
+		}
+		line = trimmed[1:]
+	}
+}
+
+// tomlString parses a single-line basic or literal string, and returns the index after the closing quote.
+func tomlString(v string) (string, int, error) {
+	quote := v[0]
+	for j := 1; j < len(v); j++ {
+		switch {
+		case v[j] == '\\' && quote == '"':
+			j++
+		case v[j] == quote:
+			if quote == '\'' {
+				return v[1:j], j + 1, nil
+			}
+			s, err := unquoteTOML(v[1:j], false)
+			return s, j + 1, err
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string %q", v)
+}
+
+// unquoteTOML unescapes the basic strings, a backslash at the end of a line trims the following whitespaces in the multi-line strings.
+func unquoteTOML(v string, multiline bool) (string, error) {
+	var b strings.Builder
+	for j := 0; j < len(v); j++ {
+		if v[j] != '\\' {
+			b.WriteByte(v[j])
+			continue
+		}
+		j++
+		if j == len(v) {
+			return "", fmt.Errorf("invalid escape at the end of %q", v)
+		}
+		switch c := v[j]; c {
+		case 'b':
+			b.WriteByte('\b')
+		case 't':
+			b.WriteByte('\t')
+		case 'n':
+			b.WriteByte('\n')
+		case 'f':
+			b.WriteByte('\f')
+		case 'r':
+			b.WriteByte('\r')
+		case '"', '\\':
+			b.WriteByte(c)
+		case 'u', 'U':
+			size := 4
+			if c == 'U' {
+				size = 8
+			}
+			if j+1+size > len(v) {
+				return "", fmt.Errorf("invalid unicode escape in %q", v)
+			}
+			r, err := strconv.ParseUint(v[j+1:j+1+size], 16, 32)
+			if err != nil {
+				return "", fmt.Errorf("invalid unicode escape in %q", v)
+			}
+			b.WriteRune(rune(r))
+			j += size
+		default:
+			if multiline && (c == ' ' || c == '\t' || c == '\n') {
+				rest := strings.TrimLeft(v[j:], " \t\n")
+				j = len(v) - len(rest) - 1
+				continue
+			}
+			return "", fmt.Errorf("invalid escape \\%c in %q", c, v)
+		}
+	}
+	return b.String(), nil
+}
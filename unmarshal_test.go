@@ -0,0 +1,104 @@
+package i18n
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestExtUnmarshaler(t *testing.T) {
+	assert := assert.New(t)
+
+	i := New("en-us")
+	assert.NoError(i.LoadGlob("test/formats/*.*"))
+
+	en := i.NewLocale("en-us")
+	assert.Equal("Checkout", en.String("title"))
+	assert.Equal("Publish", en.StringX("Post", "verb"))
+	assert.Equal("3 items", en.Number("checkout.items", 3))
+	assert.Equal("Credit card — Visa", en.String("checkout.payment.card"))
+	assert.Equal("Your order will be shipped soon.", en.String("checkout.notice"))
+
+	tw := i.NewLocale("zh-tw")
+	assert.Equal("結帳", tw.String("title"))
+	assert.Equal("發佈", tw.StringX("Post", "verb"))
+	assert.Equal("0 個商品", tw.Number("items", 0))
+	assert.Equal("3 個商品", tw.Number("items", 3))
+	assert.Equal("shipping", tw.String("shipping"))
+	assert.Equal("untranslated", tw.String("untranslated"))
+	assert.Equal("歡迎，Yami", tw.String("welcome", map[string]any{"Name": "Yami"}))
+	assert.Equal("輸入 | 輸出", tw.String("io"))
+	assert.Equal("輸入 | 輸出", tw.Number("io", 3))
+	assert.Equal(`\ 請見 @:title <現在>`, tw.String("mention"))
+
+	ja := i.NewLocale("ja")
+	assert.Equal("チェックアウト", ja.String("title"))
+	assert.Equal("3 個の商品", ja.String("checkout.items", map[string]any{"Count": 3}))
+	assert.Equal("投稿", ja.StringX("Post", "verb"))
+	assert.Equal("😀", ja.String("emoji"))
+	assert.Equal("まもなく発送されます。", ja.String("notice"))
+
+	assert.Equal("Kasse", i.NewLocale("de").String("title"))
+
+	// JSON and YAML.
+	i = New("zh-tw")
+	assert.NoError(i.LoadGlob("test/*.json", "test/*.yml"))
+	assert.Equal("訊息 A", i.NewLocale("zh-tw").String("message_a"))
+	assert.Equal("訊息 B", i.NewLocale("zh-tw").String("message_b"))
+
+	// Unknown extension.
+	i = New("en-us")
+	assert.EqualError(i.LoadFiles("go.mod"), `go.mod: no unmarshaler for the ".mod" extension, register one with WithExtUnmarshaler`)
+
+	// Custom.
+	i = New("en-us", WithExtUnmarshaler(".YML", func(data []byte, v any) error {
+		return errors.New("custom")
+	}))
+	assert.EqualError(i.LoadFiles("test/formats/de.yml"), "test/formats/de.yml: custom")
+
+	// `WithUnmarshaler` is used for all the files.
+	i = New("zh-tw", WithUnmarshaler(yaml.Unmarshal))
+	assert.NoError(i.LoadFiles("test/zh-tw.json", "test/zh_tW.yml"))
+	assert.Equal("訊息 A", i.NewLocale("zh-tw").String("message_a"))
+}
+
+func TestUnmarshalPOPlurals(t *testing.T) {
+	assert := assert.New(t)
+
+	po := `msgid "items"
+msgid_plural "items"
+msgstr[0] "{{ .Count }} item"
+msgstr[1] "{{ .Count }} items"
+`
+	var m map[string]string
+	assert.NoError(UnmarshalPO([]byte(po), &m))
+	assert.Equal("{{ .Count }} items | {{ .Count }} item | {{ .Count }} items", m["items"])
+
+	i := New("en-us")
+	assert.NoError(i.LoadMap(map[string]map[string]string{"en-us": m}))
+	assert.Equal("0 items", i.NewLocale("en-us").Number("items", 0))
+	assert.Equal("1 item", i.NewLocale("en-us").Number("items", 1))
+	assert.Equal("3 items", i.NewLocale("en-us").Number("items", 3))
+
+	m = nil
+	assert.NoError(UnmarshalPO([]byte("msgid \"\"\nmsgstr \"Plural-Forms: nplurals=2; plural=(n > 1);\\n\"\n\n"+po), &m))
+	assert.Equal("{{ .Count }} item | {{ .Count }} items", m["items"])
+
+	m = nil
+	assert.NoError(UnmarshalPO([]byte("msgid \"\"\nmsgstr \"\"\n\"Plural-Forms: nplurals=1; plural=0;\\n\"\n\n"+po), &m))
+	assert.Equal("{{ .Count }} item", m["items"])
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	var m map[string]string
+	assert.EqualError(UnmarshalTOML([]byte("count = 3"), &m), "line 1: only the strings are supported")
+	assert.EqualError(UnmarshalTOML([]byte("a = \"b\"\nc = \"d"), &m), `line 2: unterminated string "\"d"`)
+	assert.EqualError(UnmarshalTOML([]byte("[[items]]"), &m), `line 1: invalid table "[[items]]"`)
+	assert.EqualError(UnmarshalPO([]byte("msgstr \"a\""), &m), "line 1: msgstr without msgid")
+	assert.EqualError(UnmarshalProperties([]byte(`a = \u12`), &m), `line 1: invalid unicode escape "\\u12"`)
+	assert.EqualError(UnmarshalProperties([]byte("a = b"), m), "unsupported type map[string]string, it should be *map[string]string")
+}